@@ -0,0 +1,11 @@
+data "routeros_certificates" "server" {
+  filter = {
+    common_name = "router.lan"
+  }
+}
+
+resource "routeros_ip_service" "www_ssl" {
+  numbers     = "www-ssl"
+  port        = 443
+  certificate = data.routeros_certificates.server.data[0].name
+}
@@ -0,0 +1,14 @@
+data "routeros_generic" "ethernet" {
+  path   = "/interface"
+  filter = { type = "ether" }
+}
+
+data "routeros_generic" "jumbo" {
+  path     = "/interface"
+  where    = [">mtu=1500", "running=true"]
+  proplist = ["name", "mtu"]
+}
+
+output "jumbo_interfaces" {
+  value = [for i in data.routeros_generic.jumbo.items : i.name]
+}
\ No newline at end of file
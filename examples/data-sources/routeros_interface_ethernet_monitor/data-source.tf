@@ -0,0 +1,11 @@
+data "routeros_interface_ethernet_monitor" "sfp1" {
+  interface = "sfp-sfpplus1"
+}
+
+output "sfp1_optics" {
+  value = {
+    rate        = data.routeros_interface_ethernet_monitor.sfp1.rate
+    temperature = data.routeros_interface_ethernet_monitor.sfp1.sfp_temperature
+    rx_power    = data.routeros_interface_ethernet_monitor.sfp1.sfp_rx_power
+  }
+}
@@ -0,0 +1,10 @@
+data "routeros_interface_lte_monitor" "lte1" {
+  interface = "lte1"
+}
+
+output "lte1_signal" {
+  value = {
+    rsrp = data.routeros_interface_lte_monitor.lte1.rsrp
+    sinr = data.routeros_interface_lte_monitor.lte1.sinr
+  }
+}
@@ -0,0 +1,9 @@
+data "routeros_interface_wireguard_peers" "wg1" {
+  filter = {
+    interface = "wg1"
+  }
+}
+
+output "wg1_handshakes" {
+  value = { for p in data.routeros_interface_wireguard_peers.wg1.data : p.public_key => p.last_handshake }
+}
@@ -1 +1,31 @@
-data "routeros_interfaces" "interfaces" {}
\ No newline at end of file
+data "routeros_interfaces" "interfaces" {}
+
+data "routeros_interfaces" "sfp" {
+  filter = {
+    type = "ether"
+  }
+  name_regex = "^sfp"
+}
+
+data "routeros_interfaces" "jumbo" {
+  query {
+    key     = "mtu"
+    greater = "1500"
+  }
+  query {
+    key       = "type"
+    not_equal = "bridge"
+  }
+}
+
+data "routeros_interfaces" "uplinks" {
+  query {
+    key = "name"
+    in  = ["ether1", "ether2"]
+  }
+  query {
+    key   = "comment"
+    equal = "uplink"
+    or    = true
+  }
+}
@@ -1 +1,11 @@
-data "routeros_ip_addresses" "ip_addresses" {}
\ No newline at end of file
+data "routeros_ip_addresses" "ip_addresses" {}
+data "routeros_ip_addresses" "static" {
+  query {
+    key   = "dynamic"
+    equal = "false"
+  }
+  query {
+    key   = "interface"
+    regex = "^vlan"
+  }
+}
@@ -0,0 +1,5 @@
+data "routeros_ip_cloud" "cloud" {}
+
+output "ddns_name" {
+  value = data.routeros_ip_cloud.cloud.dns_name
+}
@@ -0,0 +1,5 @@
+data "routeros_ip_hotspot_active" "guests" {
+  filter = {
+    server = "hotspot1"
+  }
+}
@@ -0,0 +1,5 @@
+data "routeros_ip_neighbors" "neighbors" {
+  filter = {
+    interface = "ether1"
+  }
+}
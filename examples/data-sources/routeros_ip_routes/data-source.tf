@@ -1 +1,9 @@
-data "routeros_ip_routes" "ip_routes" {}
\ No newline at end of file
+data "routeros_ip_routes" "ip_routes" {}
+
+data "routeros_ip_routes" "bgp" {
+  filter = {
+    routing_table = "main"
+    bgp           = true
+    active        = true
+  }
+}
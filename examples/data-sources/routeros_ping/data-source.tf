@@ -0,0 +1,9 @@
+data "routeros_ping" "upstream" {
+  address     = "192.0.2.1"
+  count       = 3
+  src_address = "192.0.2.2"
+}
+
+output "upstream_reachable" {
+  value = data.routeros_ping.upstream.packet_loss < 100
+}
@@ -0,0 +1,9 @@
+data "routeros_ppp_active" "pppoe" {
+  filter = {
+    service = "pppoe"
+  }
+}
+
+output "pppoe_sessions" {
+  value = length(data.routeros_ppp_active.pppoe.data)
+}
@@ -0,0 +1,9 @@
+data "routeros_routing_bgp_sessions" "upstream" {
+  filter = {
+    name = "upstream-1"
+  }
+}
+
+output "upstream_established" {
+  value = alltrue([for s in data.routeros_routing_bgp_sessions.upstream.data : s.established])
+}
@@ -0,0 +1,9 @@
+data "routeros_routing_ospf_neighbors" "backbone" {
+  filter = {
+    area = "backbone-v2"
+  }
+}
+
+output "full_adjacencies" {
+  value = [for n in data.routeros_routing_ospf_neighbors.backbone.data : n.router_id if n.state == "Full"]
+}
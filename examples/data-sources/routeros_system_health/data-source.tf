@@ -0,0 +1,5 @@
+data "routeros_system_health" "temperature" {
+  filter = {
+    name = "temperature"
+  }
+}
@@ -0,0 +1 @@
+data "routeros_system_license" "license" {}
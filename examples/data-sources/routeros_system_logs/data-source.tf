@@ -0,0 +1,5 @@
+data "routeros_system_logs" "login_failures" {
+  topics        = ["system", "error"]
+  message_regex = "login failure"
+  max_count     = 10
+}
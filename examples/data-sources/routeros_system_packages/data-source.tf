@@ -0,0 +1,11 @@
+data "routeros_system_packages" "container" {
+  filter = {
+    name = "container"
+  }
+}
+
+resource "routeros_container_config" "config" {
+  count        = length(data.routeros_system_packages.container.data) > 0 ? 1 : 0
+  registry_url = "https://registry-1.docker.io"
+  tmpdir       = "pull"
+}
@@ -0,0 +1,5 @@
+data "routeros_system_resources" "data" {}
+
+output "model" {
+  value = data.routeros_system_resources.data.routerboard ? data.routeros_system_resources.data.model : "CHR"
+}
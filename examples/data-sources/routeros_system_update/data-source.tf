@@ -0,0 +1,5 @@
+data "routeros_system_update" "update" {}
+
+output "update_available" {
+  value = data.routeros_system_update.update.installed_version != data.routeros_system_update.update.latest_version
+}
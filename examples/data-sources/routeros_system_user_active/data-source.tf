@@ -0,0 +1,5 @@
+data "routeros_system_user_active" "admins" {
+  filter = {
+    group = "full"
+  }
+}
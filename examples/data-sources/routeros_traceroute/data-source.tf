@@ -0,0 +1,8 @@
+data "routeros_traceroute" "upstream" {
+  address  = "192.0.2.1"
+  max_hops = 10
+}
+
+output "upstream_path" {
+  value = [for h in data.routeros_traceroute.upstream.data : h.address]
+}
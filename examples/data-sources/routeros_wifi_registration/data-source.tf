@@ -0,0 +1,5 @@
+data "routeros_wifi_registration" "clients" {
+  filter = {
+    interface = "wifi1"
+  }
+}
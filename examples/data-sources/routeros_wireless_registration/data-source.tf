@@ -0,0 +1,5 @@
+data "routeros_wireless_registration" "clients" {
+  filter = {
+    interface = "wlan1"
+  }
+}
@@ -0,0 +1,13 @@
+ephemeral "routeros_wireguard_keys" "client" {}
+
+# Ephemeral values can be passed to write-only arguments, e.g. to store the client keys in Vault.
+resource "vault_kv_secret_v2" "wg_client" {
+  mount = "secret"
+  name  = "wireguard/client"
+  data_json_wo = jsonencode({
+    private_key   = ephemeral.routeros_wireguard_keys.client.private_key
+    public_key    = ephemeral.routeros_wireguard_keys.client.public_key
+    preshared_key = ephemeral.routeros_wireguard_keys.client.preshared_key
+  })
+  data_json_wo_version = 1
+}
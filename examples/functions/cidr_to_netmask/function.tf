@@ -0,0 +1,3 @@
+output "netmask" {
+  value = provider::routeros::cidr_to_netmask("192.168.88.0/24") # 255.255.255.0
+}
@@ -0,0 +1,5 @@
+data "routeros_system_resource" "data" {}
+
+output "boot_time" {
+  value = timeadd(plantimestamp(), "-${provider::routeros::from_ros_duration(data.routeros_system_resource.data.uptime)}")
+}
@@ -0,0 +1,4 @@
+resource "routeros_ip_dhcp_server_lease" "printer" {
+  address     = "192.168.88.20"
+  mac_address = provider::routeros::mac_normalize("00-0c-29-00-01-a0") # 00:0C:29:00:01:A0
+}
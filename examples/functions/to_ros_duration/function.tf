@@ -0,0 +1,6 @@
+resource "routeros_ip_dhcp_server" "lan" {
+  name         = "lan"
+  interface    = "bridge"
+  address_pool = "dhcp-pool"
+  lease_time   = provider::routeros::to_ros_duration("36h") # 1d12h
+}
@@ -0,0 +1,4 @@
+#The ID of an item is its path and internal ID
+terraform import routeros_generic.list "/interface/list/*2000010"
+#The ID of the settings menu is its path
+terraform import routeros_generic.modbus "/iot/modbus"
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "routeros_generic" "modbus" {
+  path      = "/iot/modbus"
+  singleton = true
+  attributes = {
+    disabled      = "no"
+    hardware-port = "serial0"
+  }
+}
+
+resource "routeros_generic" "list" {
+  path = "/interface/list"
+  attributes = {
+    name    = "lan-devices"
+    comment = "Managed by the generic resource"
+  }
+}
@@ -1,3 +1,5 @@
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/interface/bridge get [print show-ids]]
 terraform import routeros_interface_bridge.bridge "*1"
+#Or you can import a resource using one of its attributes
+terraform import routeros_interface_bridge.bridge "name=bridge1"
\ No newline at end of file
@@ -0,0 +1,2 @@
+#The ID is the name of the interface list
+terraform import routeros_interface_list_member_set.wan WAN
@@ -0,0 +1,8 @@
+resource "routeros_interface_list" "wan" {
+  name = "WAN"
+}
+
+resource "routeros_interface_list_member_set" "wan" {
+  list       = routeros_interface_list.wan.name
+  interfaces = [for k, v in module.uplinks : v.interface]
+}
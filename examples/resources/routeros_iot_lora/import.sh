@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/iot/lora get [print show-ids]]
+terraform import routeros_iot_lora.lora1 '*1'
@@ -0,0 +1,13 @@
+resource "routeros_iot_lora_server" "ttn" {
+  name    = "TTN-EU"
+  address = "eu1.cloud.thethings.industries"
+}
+
+resource "routeros_iot_lora" "lora1" {
+  name         = "lora1"
+  antenna_gain = 3
+  channel_plan = "EU868"
+  network      = "public"
+  servers      = [routeros_iot_lora_server.ttn.name]
+  disabled     = false
+}
@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/iot/lora/servers get [print show-ids]]
+terraform import routeros_iot_lora_server.ttn '*1'
+#Or you can import a resource using one of its attributes
+terraform import routeros_iot_lora_server.ttn "name=TTN-EU"
@@ -0,0 +1,6 @@
+resource "routeros_iot_lora_server" "ttn" {
+  name      = "TTN-EU"
+  address   = "eu1.cloud.thethings.industries"
+  up_port   = 1700
+  down_port = 1700
+}
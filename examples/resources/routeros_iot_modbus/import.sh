@@ -0,0 +1 @@
+terraform import routeros_iot_modbus.settings .
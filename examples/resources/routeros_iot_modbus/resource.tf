@@ -0,0 +1,6 @@
+resource "routeros_iot_modbus" "settings" {
+  disabled      = false
+  hardware_port = "modbus"
+  tcp_port      = 502
+  timeout       = 1000
+}
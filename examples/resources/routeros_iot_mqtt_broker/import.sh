@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/iot/mqtt/brokers get [print show-ids]]
+terraform import routeros_iot_mqtt_broker.test '*1'
+#Or you can import a resource using one of its attributes
+terraform import routeros_iot_mqtt_broker.test "name=mqtt-01"
@@ -0,0 +1,8 @@
+resource "routeros_iot_mqtt_broker" "test" {
+  name         = "mqtt-01"
+  address      = "192.168.88.2"
+  auto_connect = true
+  client_id    = "router-01"
+  username     = "router"
+  password     = "password"
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/iot/mqtt/subscriptions get [print show-ids]]
+terraform import routeros_iot_mqtt_subscription.test '*1'
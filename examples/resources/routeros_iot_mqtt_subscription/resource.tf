@@ -0,0 +1,11 @@
+resource "routeros_iot_mqtt_broker" "broker" {
+  name    = "mqtt-01"
+  address = "192.168.88.2"
+}
+
+resource "routeros_iot_mqtt_subscription" "test" {
+  broker     = routeros_iot_mqtt_broker.broker.name
+  topic      = "router/commands"
+  qos        = 1
+  on_message = ":log info $msgData"
+}
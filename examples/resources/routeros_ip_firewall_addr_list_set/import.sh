@@ -0,0 +1,2 @@
+#The ID is the name of the address list
+terraform import routeros_ip_firewall_addr_list_set.blocklist blocklist
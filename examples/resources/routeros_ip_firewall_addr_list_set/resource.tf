@@ -0,0 +1,4 @@
+resource "routeros_ip_firewall_addr_list_set" "blocklist" {
+  list      = "blocklist"
+  addresses = split("\n", trimspace(file("${path.module}/blocklist.txt")))
+}
@@ -1,3 +1,5 @@
 #The ID can be found via API or the terminal
 #The command for the terminal is -> :put [/ip/firewall/filter get [print show-ids]]
-terraform import routeros_ip_firewall_filter.rule "*0"
\ No newline at end of file
+terraform import routeros_ip_firewall_filter.rule "*0"
+#Or you can import a resource using one of its attributes
+terraform import routeros_ip_firewall_filter.rule "comment=Allow SSH"
\ No newline at end of file
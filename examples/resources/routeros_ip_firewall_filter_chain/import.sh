@@ -0,0 +1,2 @@
+#The ID is the name of the chain
+terraform import routeros_ip_firewall_filter_chain.input input
\ No newline at end of file
@@ -0,0 +1,27 @@
+resource "routeros_ip_firewall_filter_chain" "input" {
+  chain = "input"
+
+  rule {
+    action           = "accept"
+    connection_state = "established,related,untracked"
+    comment          = "Accept established, related, untracked"
+  }
+
+  rule {
+    action           = "drop"
+    connection_state = "invalid"
+    comment          = "Drop invalid"
+  }
+
+  rule {
+    action   = "accept"
+    protocol = "icmp"
+    comment  = "Accept ICMP"
+  }
+
+  rule {
+    action            = "drop"
+    in_interface_list = "!LAN"
+    comment           = "Drop all not coming from LAN"
+  }
+}
@@ -0,0 +1,9 @@
+resource "routeros_ip_firewall_nat_chain" "srcnat" {
+  chain = "srcnat"
+
+  rule {
+    action             = "masquerade"
+    out_interface_list = "WAN"
+    comment            = "Masquerade"
+  }
+}
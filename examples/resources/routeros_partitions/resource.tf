@@ -0,0 +1,4 @@
+resource "routeros_partitions" "part1" {
+  name        = "part1"
+  fallback_to = "part0"
+}
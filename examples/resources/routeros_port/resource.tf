@@ -0,0 +1,6 @@
+resource "routeros_port" "serial0" {
+  name      = "serial0"
+  baud_rate = "115200"
+  parity    = "none"
+  stop_bits = 1
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/queue/interface get [print show-ids]]
+terraform import routeros_queue_interface.ether1 *1
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "routeros_queue_interface" "ether1" {
+  interface = "ether1"
+  queue     = "ethernet-default"
+}
@@ -0,0 +1,4 @@
+resource "routeros_special_login" "console" {
+  user = "console"
+  port = "serial0"
+}
@@ -0,0 +1 @@
+terraform import routeros_system_device_mode.settings .
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "routeros_system_device_mode" "settings" {
+  mode               = "advanced"
+  container          = true
+  activation_timeout = "10m"
+}
@@ -0,0 +1,3 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/system/package get [print show-ids]]
+terraform import routeros_system_package.wireless *2
\ No newline at end of file
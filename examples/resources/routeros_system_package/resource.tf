@@ -0,0 +1,5 @@
+resource "routeros_system_package" "wireless" {
+  name          = "wireless"
+  disabled      = true
+  apply_changes = true
+}
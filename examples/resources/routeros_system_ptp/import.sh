@@ -0,0 +1,5 @@
+#The ID can be found via API or the terminal
+#The command for the terminal is -> :put [/system/ptp get [print show-ids]]
+terraform import routeros_system_ptp.ptp1 '*1'
+#Or you can import a resource using one of its attributes
+terraform import routeros_system_ptp.ptp1 "name=ptp1"
\ No newline at end of file
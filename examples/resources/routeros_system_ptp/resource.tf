@@ -0,0 +1,5 @@
+resource "routeros_system_ptp" "ptp1" {
+  name      = "ptp1"
+  profile   = "default"
+  priority1 = 100
+}
@@ -0,0 +1,8 @@
+resource "routeros_system_ptp" "ptp1" {
+  name = "ptp1"
+}
+
+resource "routeros_system_ptp_port" "sfp1" {
+  interface = "sfp1"
+  ptp       = routeros_system_ptp.ptp1.name
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/terraform-routeros/terraform-provider-routeros/routeros"
@@ -11,16 +15,60 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
 func main() {
-	var debug bool
+	var debug, importsOnly bool
+	var generate, resources string
 
 	// https://github.com/hashicorp/terraform-docs-common/blob/main/website/docs/plugin/debugging.mdx
 	// https://developer.hashicorp.com/terraform/plugin/debugging
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&generate, "generate-config", "", "write the configuration of the router configured by the ROS_* "+
+		"environment variables to the file ('-' for stdout) and exit")
+	flag.BoolVar(&importsOnly, "imports-only", false, "generate only the import blocks "+
+		"for 'terraform plan -generate-config-out'")
+	flag.StringVar(&resources, "resources", "", "comma-separated list of the resource types to generate")
 	flag.Parse()
 
+	if generate != "" {
+		if err := generateConfig(generate, resources, importsOnly); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// The tracer provider is global, it is registered once for all provider instances.
+	if err := routeros.InitTracing(context.Background()); err != nil {
+		log.Printf("[WARN] Tracing is disabled: %v", err)
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
-		ProviderAddr: "terraform-routeros/routeros",
-		ProviderFunc: routeros.NewProvider,
-		Debug:        debug,
+		ProviderAddr:     "terraform-routeros/routeros",
+		GRPCProviderFunc: routeros.NewProtocolServer,
+		Debug:            debug,
 	})
+
+	// Export the remaining tracing spans.
+	routeros.ShutdownTracing(context.Background())
+	routeros.WriteMetrics()
+}
+
+func generateConfig(path, resources string, importsOnly bool) error {
+	opts := routeros.GenerateOptions{ImportsOnly: importsOnly}
+	if resources != "" {
+		opts.Resources = strings.Split(resources, ",")
+	}
+
+	if path == "-" {
+		return routeros.GenerateConfig(context.Background(), os.Stdout, opts)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err = routeros.GenerateConfig(context.Background(), f, opts); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
 }
@@ -0,0 +1,165 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceCertificatesRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/certificate"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"akid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authority": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ca": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"common_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"crl": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"days_valid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"digest_algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expired": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expires_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fingerprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invalid_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invalid_before": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issued": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"issuer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_usage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locality": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_key": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"revoked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"serial_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"skid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"smart_card_key": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_alt_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trusted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceCertificatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceCertificates().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceCertificates = "data.routeros_certificates.data"
+
+func TestAccDatasourceCertificatesTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceCertificatesConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceCertificates),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceCertificatesConfig() string {
+	return providerConfig + `
+
+data "routeros_certificates" "data" {}
+`
+}
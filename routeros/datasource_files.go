@@ -15,6 +15,7 @@ func DatasourceFiles() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"files": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -71,10 +72,10 @@ func datasourceFilesRead(ctx context.Context, d *schema.ResourceData, m interfac
 	s := DatasourceFiles().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "files", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "files", s, d)
 }
@@ -0,0 +1,123 @@
+package routeros
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DatasourceGeneric Reads the items of any menu: the tables that have no typed data source yet.
+func DatasourceGeneric() *schema.Resource {
+	return &schema.Resource{
+		Description: "A data source for any menu path: the items are returned as maps of the RouterOS properties.",
+		ReadContext: datasourceGenericRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL path of the menu in the notation ```/interface/ethernet```.",
+				ValidateFunc: validation.StringMatch(reGenericPath, ""),
+			},
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"where": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Description: "Query words of the print command without the leading ```?```: ```[\"type=ether\", " +
+					"\"type=vlan\", \"#|\"]``` returns the Ethernet and VLAN interfaces, ```[\">mtu=1500\"]``` the " +
+					"interfaces with the MTU greater than 1500.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"proplist": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Properties to be read, all by default.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Items of the menu.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceGenericRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+	filter := buildReadFilter(d.Get(KeyFilter).(map[string]interface{}))
+
+	var proplist []string
+	for _, v := range d.Get("proplist").([]interface{}) {
+		proplist = append(proplist, v.(string))
+	}
+
+	var where []string
+	for _, v := range d.Get("where").([]interface{}) {
+		where = append(where, v.(string))
+	}
+
+	words, patterns, err := buildQuery(d.Get(KeyQuery).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := readItemsQuery(path, filter, append(where, words...), patterns, m.(Client), proplist...)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := make([]map[string]string, 0, len(*res))
+	for _, item := range *res {
+		items = append(items, item)
+	}
+
+	if err = d.Set("items", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(UniqueId())
+
+	return nil
+}
+
+// readItemsWhere Reads the items by the query words of the print command.
+// REST: POST /path/print {".query": ["type=ether", "type=vlan", "#|"]}
+// API:  /path/print ?type=ether ?type=vlan ?#|
+// SSH:  /path/print where (type="ether" or type="vlan")
+func readItemsWhere(path string, query, proplist []string, c Client) (*[]MikrotikItem, error) {
+	var res []MikrotikItem
+
+	if c.GetTransport() == TransportREST {
+		var item MikrotikItem
+		if len(proplist) > 0 {
+			item = MikrotikItem{".proplist": strings.Join(proplist, ",")}
+		}
+		err := c.SendRequest(crudPrint, &URL{Path: path, Query: query}, item, &res)
+		return &res, err
+	}
+
+	url := &URL{Path: path}
+	for _, w := range query {
+		url.Query = append(url.Query, "?"+w)
+	}
+	if len(proplist) > 0 {
+		url.Query = append(url.Query, proplistQuery(c, proplist))
+	}
+
+	err := c.SendRequest(crudRead, url, nil, &res)
+	return &res, err
+}
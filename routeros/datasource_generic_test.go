@@ -0,0 +1,79 @@
+package routeros
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceGeneric = "data.routeros_generic.interfaces"
+
+func TestAccDatasourceGenericTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceGenericConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceGeneric),
+							resource.TestCheckResourceAttr(testDatasourceGeneric, "items.0.type", "ether"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceGenericConfig() string {
+	return providerConfig + `
+
+data "routeros_generic" "interfaces" {
+	path     = "/interface"
+	where    = ["type=ether", "type=bridge", "#|"]
+	proplist = ["name", "type"]
+}
+`
+}
+
+type testWhereClient struct {
+	testOperationClient
+	transport TransportType
+	method    crudMethod
+	url       *URL
+	item      MikrotikItem
+}
+
+func (c *testWhereClient) GetTransport() TransportType { return c.transport }
+func (c *testWhereClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	c.method, c.url, c.item = method, url, item
+	return nil
+}
+
+func TestReadItemsWhere(t *testing.T) {
+	c := &testWhereClient{transport: TransportREST}
+	if _, err := readItemsWhere("/interface", []string{"type=ether", ">mtu=1500"}, []string{"name"}, c); err != nil {
+		t.Fatal(err)
+	}
+	if c.method != crudPrint || !reflect.DeepEqual(c.url.Query, []string{"type=ether", ">mtu=1500"}) ||
+		c.item[".proplist"] != "name" {
+		t.Errorf("wrong REST request: %v %v %v", c.method, c.url, c.item)
+	}
+
+	c = &testWhereClient{transport: TransportAPI}
+	if _, err := readItemsWhere("/interface", []string{"type=ether", ">mtu=1500"}, []string{"name"}, c); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"?type=ether", "?>mtu=1500", "=.proplist=name"}; c.method != crudRead ||
+		!reflect.DeepEqual(c.url.Query, want) {
+		t.Errorf("wrong API request: %v %v, want %v", c.method, c.url.Query, want)
+	}
+}
@@ -17,6 +17,7 @@ func DatasourceInterfaceBridgeFilter() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"filters": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -73,10 +74,10 @@ func datasourceInterfaceBridgeFiltersRead(ctx context.Context, d *schema.Resourc
 	s := DatasourceInterfaceBridgeFilter().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "filters", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "filters", s, d)
 }
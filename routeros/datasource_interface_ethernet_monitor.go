@@ -0,0 +1,139 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceInterfaceEthernetMonitor() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/ethernet"),
+		MetaId:           PropId(Id),
+
+		"advertising": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"auto_negotiation": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"default_cable_settings": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"full_duplex": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the ethernet interface.",
+		},
+		"link_partner_advertising": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rate": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rx_flow_control": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_connector_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_link_length_copper": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_link_length_sm": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_manufacturing_date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_module_present": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"sfp_rx_loss": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"sfp_rx_power": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_supply_voltage": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_temperature": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_tx_bias_current": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_tx_fault": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"sfp_tx_power": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_vendor_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_vendor_part_number": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_vendor_revision": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_vendor_serial": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sfp_wavelength": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"supported": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tx_flow_control": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: DefaultMonitorDatasourceRead(resSchema),
+		Schema:      resSchema,
+	}
+}
@@ -0,0 +1,42 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceInterfaceEthernetMonitor = "data.routeros_interface_ethernet_monitor.data"
+
+func TestAccDatasourceInterfaceEthernetMonitorTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceInterfaceEthernetMonitorConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceInterfaceEthernetMonitor),
+							resource.TestCheckResourceAttr(testDatasourceInterfaceEthernetMonitor, "name", "ether1"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceInterfaceEthernetMonitorConfig() string {
+	return providerConfig + `
+
+data "routeros_interface_ethernet_monitor" "data" {
+  interface = "ether1"
+}
+`
+}
@@ -0,0 +1,135 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceInterfaceLteMonitor() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/lte"),
+		MetaId:           PropId(Id),
+
+		"access_technology": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ca_band": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"cqi": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"current_cellid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"current_operator": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"earfcn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enb_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"functionality": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"imei": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the LTE interface.",
+		},
+		"imsi": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"lac": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"manufacturer": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mcc": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mnc": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"model": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"phy_cellid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"pin_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"primary_band": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"registration_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"revision": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ri": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rsrp": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rsrq": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"rssi": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sector_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"session_uptime": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"sinr": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"uicc": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: DefaultMonitorDatasourceRead(resSchema),
+		Schema:      resSchema,
+	}
+}
@@ -0,0 +1,47 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceInterfaceLteMonitor = "data.routeros_interface_lte_monitor.data"
+
+func TestAccDatasourceInterfaceLteMonitorTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/interface/lte", "name=lte1") {
+		t.Logf("Test skipped, the router does not have the lte1 interface")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceInterfaceLteMonitorConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceInterfaceLteMonitor),
+							resource.TestCheckResourceAttrSet(testDatasourceInterfaceLteMonitor, "imei"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceInterfaceLteMonitorConfig() string {
+	return providerConfig + `
+
+data "routeros_interface_lte_monitor" "data" {
+  interface = "lte1"
+}
+`
+}
@@ -0,0 +1,134 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceInterfaceWireguardPeers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceInterfaceWireguardPeersRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/interface/wireguard/peers"),
+			MetaId:           PropId(Id),
+			MetaSkipFields:   PropSkipFields("preshared_key", "private_key"),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_dns": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_keepalive": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_listen_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_endpoint_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_endpoint_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"dynamic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"endpoint_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_responder": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"last_handshake": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"persistent_keepalive": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"responder": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"rx": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tx": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceInterfaceWireguardPeersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceInterfaceWireguardPeers().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceInterfaceWireguardPeers = "data.routeros_interface_wireguard_peers.data"
+
+func TestAccDatasourceInterfaceWireguardPeersTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceInterfaceWireguardPeersConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceInterfaceWireguardPeers),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceInterfaceWireguardPeersConfig() string {
+	return providerConfig + `
+
+data "routeros_interface_wireguard_peers" "data" {}
+`
+}
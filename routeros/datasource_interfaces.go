@@ -2,9 +2,11 @@ package routeros
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func DatasourceInterfaces() *schema.Resource {
@@ -19,6 +21,13 @@ func DatasourceInterfaces() *schema.Resource {
 				"tx_drop", "tx_error", "tx_packet", "tx_queue_drop",
 			),
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A regular expression that the interface names must match, e.g. `^sfp-sfpplus`.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
 			"interfaces": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -104,10 +113,22 @@ func datasourceInterfacesRead(ctx context.Context, d *schema.ResourceData, m int
 	s := DatasourceInterfaces().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "interfaces", s, d)
+	// The API can't match names by a regular expression, so filter on our side.
+	if v, ok := d.GetOk("name_regex"); ok {
+		re := regexp.MustCompile(v.(string))
+		var filtered []MikrotikItem
+		for _, item := range *res {
+			if re.MatchString(item["name"]) {
+				filtered = append(filtered, item)
+			}
+		}
+		res = &filtered
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "interfaces", s, d)
 }
@@ -24,6 +24,14 @@ func TestAccDatasourceInterfacesTest_basic(t *testing.T) {
 							testResourcePrimaryInstanceId(testDatasourceInterfaces),
 						),
 					},
+					{
+						Config: testAccDatasourceInterfacesFilterConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceInterfaces),
+							resource.TestCheckResourceAttr(testDatasourceInterfaces, "interfaces.#", "1"),
+							resource.TestCheckResourceAttr(testDatasourceInterfaces, "interfaces.0.name", "ether1"),
+						),
+					},
 				},
 			})
 
@@ -37,3 +45,15 @@ func testAccDatasourceInterfacesConfig() string {
 data "routeros_interfaces" "interfaces" {}
 `
 }
+
+func testAccDatasourceInterfacesFilterConfig() string {
+	return providerConfig + `
+
+data "routeros_interfaces" "interfaces" {
+  filter = {
+    type = "ether"
+  }
+  name_regex = "^ether1$"
+}
+`
+}
@@ -15,6 +15,7 @@ func DatasourceIPAddresses() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"addresses": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -68,10 +69,10 @@ func datasourceIPAddressesRead(ctx context.Context, d *schema.ResourceData, m in
 	s := DatasourceIPAddresses().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "addresses", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "addresses", s, d)
 }
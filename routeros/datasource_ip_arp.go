@@ -17,6 +17,7 @@ func DatasourceIpArp() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"data": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -73,10 +74,10 @@ func datasourceIpArpRead(ctx context.Context, d *schema.ResourceData, m interfac
 	s := DatasourceIpArp().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "data", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
 }
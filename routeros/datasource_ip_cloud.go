@@ -0,0 +1,54 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceIpCloud() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ip/cloud"),
+		MetaId:           PropId(Id),
+
+		"back_to_home_vpn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ddns_enabled": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ddns_update_interval": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"dns_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"public_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"public_address_ipv6": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"update_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"warning": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: DefaultSystemDatasourceRead(resSchema),
+		Schema:      resSchema,
+	}
+}
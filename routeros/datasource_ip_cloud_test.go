@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceIpCloud = "data.routeros_ip_cloud.data"
+
+func TestAccDatasourceIpCloudTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceIpCloudConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceIpCloud),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceIpCloudConfig() string {
+	return providerConfig + `
+
+data "routeros_ip_cloud" "data" {}
+`
+}
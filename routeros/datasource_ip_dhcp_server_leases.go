@@ -17,6 +17,7 @@ func DatasourceIpDhcpServerLeases() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"data": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -121,10 +122,10 @@ func datasourceIpDhcpServerLeasesRead(ctx context.Context, d *schema.ResourceDat
 	s := DatasourceIpDhcpServerLeases().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client), datasourceProplist(routerOSVersion(m), s, "data")...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "data", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
 }
@@ -69,18 +69,24 @@ func datasourceIPFirewallFilterRead(ctx context.Context, d *schema.ResourceData,
 
 		// Snake case!
 		var res []MikrotikItem
+		// Counters and other attributes that are not in the schema are not transferred.
+		proplist := datasourceProplist(routerOSVersion(m), s, section)
 
 		for _, sectionResourceData := range d.Get(section).([]interface{}) {
 			filter := sectionResourceData.(map[string]interface{})[KeyFilter].(map[string]interface{})
+			words, patterns, err := buildQuery(sectionResourceData.(map[string]interface{})[KeyQuery].([]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
 
-			r, err := ReadItemsFiltered(buildReadFilter(filter), path, m.(Client))
+			r, err := readItemsQuery(path, buildReadFilter(filter), words, patterns, m.(Client), proplist...)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
 			res = append(res, *r...)
 		}
-		diags = append(diags, MikrotikResourceDataToTerraformDatasource(&res, section, s, d)...)
+		diags = append(diags, MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &res, section, s, d)...)
 	}
 	return diags
 }
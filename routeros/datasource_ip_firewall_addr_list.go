@@ -10,6 +10,7 @@ func getIPFirewallAddrListSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				KeyFilter: PropFilterRw,
+				KeyQuery:  PropQueryRw,
 				"id": {
 					Type:     schema.TypeString,
 					Computed: true,
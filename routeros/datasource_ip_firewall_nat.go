@@ -12,6 +12,7 @@ func getIPFirewallNatSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				KeyFilter: PropFilterRw,
+				KeyQuery:  PropQueryRw,
 				"id": {
 					Type:     schema.TypeString,
 					Computed: true,
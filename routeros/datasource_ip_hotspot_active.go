@@ -0,0 +1,129 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceIpHotspotActive() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceIpHotspotActiveRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/ip/hotspot/active"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"blocked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bytes_in": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bytes_out": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"idle_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"idle_timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keepalive_timeout": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit_bytes_in": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit_bytes_out": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit_bytes_total": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"login_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packets_in": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packets_out": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"radius": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"server": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_time_left": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceIpHotspotActiveRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceIpHotspotActive().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceIpHotspotActive = "data.routeros_ip_hotspot_active.data"
+
+func TestAccDatasourceIpHotspotActiveTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceIpHotspotActiveConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceIpHotspotActive),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceIpHotspotActiveConfig() string {
+	return providerConfig + `
+
+data "routeros_ip_hotspot_active" "data" {}
+`
+}
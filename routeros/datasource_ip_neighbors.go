@@ -0,0 +1,121 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceIpNeighbors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceIpNeighborsRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/ip/neighbor"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"age": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"board": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"discovered_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"software_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_caps": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_caps_enabled": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unpack": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceIpNeighborsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceIpNeighbors().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
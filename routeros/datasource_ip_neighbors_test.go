@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceIpNeighbors = "data.routeros_ip_neighbors.data"
+
+func TestAccDatasourceIpNeighborsTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceIpNeighborsConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceIpNeighbors),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceIpNeighborsConfig() string {
+	return providerConfig + `
+
+data "routeros_ip_neighbors" "data" {}
+`
+}
@@ -15,6 +15,7 @@ func DatasourceIPRoutes() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"routes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -28,6 +29,14 @@ func DatasourceIPRoutes() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"belongs_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bgp": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"blackhole": {
 							Type:     schema.TypeBool,
 							Computed: true,
@@ -84,10 +93,18 @@ func DatasourceIPRoutes() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"ospf": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"pref_src": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"rip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"routing_table": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -123,10 +140,10 @@ func datasourceIPRoutesRead(ctx context.Context, d *schema.ResourceData, m inter
 	s := DatasourceIPRoutes().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client), datasourceProplist(routerOSVersion(m), s, "routes")...)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "routes", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "routes", s, d)
 }
@@ -15,6 +15,7 @@ func DatasourceIPServices() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"services": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -67,10 +68,10 @@ func datasourceIPServicesRead(ctx context.Context, d *schema.ResourceData, m int
 	s := DatasourceIPServices().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "services", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "services", s, d)
 }
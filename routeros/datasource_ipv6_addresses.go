@@ -15,6 +15,7 @@ func DatasourceIPv6Addresses() *schema.Resource {
 			MetaId:           PropId(Id),
 
 			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
 			"addresses": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -91,10 +92,10 @@ func datasourceIPv6AddressesRead(ctx context.Context, d *schema.ResourceData, m
 	s := DatasourceIPv6Addresses().Schema
 	path := s[MetaResourcePath].Default.(string)
 
-	res, err := ReadItemsFiltered(buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), path, m.(Client))
+	res, err := readDatasourceItems(d, path, m.(Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraformDatasource(res, "addresses", s, d)
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "addresses", s, d)
 }
@@ -10,6 +10,7 @@ func getIPv6FirewallFilterSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				KeyFilter: PropFilterRw,
+				KeyQuery:  PropQueryRw,
 				"id": {
 					Type:     schema.TypeString,
 					Computed: true,
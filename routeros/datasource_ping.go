@@ -0,0 +1,180 @@
+package routeros
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+  "avg-rtt": "11ms412us",
+  "host": "1.1.1.1",
+  "max-rtt": "12ms31us",
+  "min-rtt": "10ms794us",
+  "packet-loss": "0",
+  "received": "2",
+  "sent": "2",
+  "seq": "1",
+  "size": "56",
+  "time": "12ms31us",
+  "ttl": "57"
+}
+*/
+
+func DatasourcePing() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePingRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/ping"),
+			MetaId:           PropId(Id),
+
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address or host name to ping.",
+			},
+			"count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				Description:  "Number of packets to send.",
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"interface": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The interface to send the packets from.",
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Size of the packets.",
+				ValidateFunc: validation.IntBetween(14, 65535),
+			},
+			"src_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The source address of the packets.",
+			},
+			"vrf": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The VRF to ping in.",
+			},
+			"avg_rtt": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_rtt": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"min_rtt": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"packet_loss": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Packet loss in percent.",
+			},
+			"received": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sent": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"seq": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourcePingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourcePing().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	item := MikrotikItem{
+		"address": d.Get("address").(string),
+		"count":   strconv.Itoa(d.Get("count").(int)),
+	}
+	for _, field := range []string{"interface", "src_address", "vrf"} {
+		if v, ok := d.GetOk(field); ok {
+			item[SnakeToKebab(field)] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("size"); ok {
+		item["size"] = strconv.Itoa(v.(int))
+	}
+
+	res := &[]MikrotikItem{}
+	if err := m.(Client).SendRequest(crudExecute, &URL{Path: path}, item, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Every reply contains the statistics collected so far, so the last one holds the totals.
+	var packets []MikrotikItem
+	var summary = MikrotikItem{}
+	for _, reply := range *res {
+		summary = reply
+
+		packet := MikrotikItem{}
+		for _, field := range []string{"host", "seq", "size", "status", "time", "ttl"} {
+			if v, ok := reply[field]; ok {
+				packet[field] = v
+			}
+		}
+		packets = append(packets, packet)
+	}
+
+	diags := MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &packets, "data", s, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, field := range []string{"avg_rtt", "max_rtt", "min_rtt"} {
+		d.Set(field, summary[SnakeToKebab(field)])
+	}
+	for _, field := range []string{"packet_loss", "received", "sent"} {
+		n, _ := strconv.Atoi(summary[SnakeToKebab(field)])
+		d.Set(field, n)
+	}
+
+	return diags
+}
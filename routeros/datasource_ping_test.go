@@ -0,0 +1,44 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourcePing = "data.routeros_ping.data"
+
+func TestAccDatasourcePingTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourcePingConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourcePing),
+							resource.TestCheckResourceAttr(testDatasourcePing, "sent", "2"),
+							resource.TestCheckResourceAttr(testDatasourcePing, "packet_loss", "0"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourcePingConfig() string {
+	return providerConfig + `
+
+data "routeros_ping" "data" {
+  address = "127.0.0.1"
+  count   = 2
+}
+`
+}
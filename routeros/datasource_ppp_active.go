@@ -0,0 +1,89 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourcePPPActive() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePPPActiveRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/ppp/active"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"caller_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encoding": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit_bytes_in": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"limit_bytes_out": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"radius": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourcePPPActiveRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourcePPPActive().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourcePPPActive = "data.routeros_ppp_active.data"
+
+func TestAccDatasourcePPPActiveTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourcePPPActiveConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourcePPPActive),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourcePPPActiveConfig() string {
+	return providerConfig + `
+
+data "routeros_ppp_active" "data" {}
+`
+}
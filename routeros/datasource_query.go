@@ -0,0 +1,151 @@
+package routeros
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// readDatasourceItems Reads the items of the data source that match the 'filter' and 'query' attributes.
+func readDatasourceItems(d *schema.ResourceData, path string, c Client, proplist ...string) (*[]MikrotikItem, error) {
+	words, patterns, err := buildQuery(d.Get(KeyQuery).([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return readItemsQuery(path, buildReadFilter(d.Get(KeyFilter).(map[string]interface{})), words, patterns, c,
+		proplist...)
+}
+
+// buildQuery Returns the query words of the print command and the regular expressions of the properties that
+// are matched by the provider, the router queries do not support them.
+// Every query leaves one value on the query stack: its conditions are joined by '#&', 'not' adds '#!' and 'or'
+// joins the query with the previous one by '#|'. The values of all queries are joined by the router.
+// {key = "mtu", greater = "1500"} -> ">mtu=1500"
+// {key = "comment", not_equal = "test"} -> "comment=test", "#!"
+// {key = "interface", in = ["ether1", "ether2"]} -> "interface=ether1", "interface=ether2", "#|"
+// {key = "comment", present = true, or = true} -> "comment", "#|"
+func buildQuery(query []interface{}) ([]string, map[string]*regexp.Regexp, error) {
+	var words []string
+	var patterns map[string]*regexp.Regexp
+
+	for i, v := range query {
+		q, _ := v.(map[string]interface{})
+		if q == nil {
+			continue
+		}
+		key := SnakeToKebab(q["key"].(string))
+
+		// The conditions that leave one value on the stack.
+		var conditions [][]string
+		if v := q["equal"].(string); v != "" {
+			conditions = append(conditions, []string{key + "=" + v})
+		}
+		if v := q["not_equal"].(string); v != "" {
+			conditions = append(conditions, []string{key + "=" + v, "#!"})
+		}
+		if v := q["less"].(string); v != "" {
+			conditions = append(conditions, []string{"<" + key + "=" + v})
+		}
+		if v := q["greater"].(string); v != "" {
+			conditions = append(conditions, []string{">" + key + "=" + v})
+		}
+		if q["present"].(bool) {
+			conditions = append(conditions, []string{key})
+		}
+		if q["absent"].(bool) {
+			conditions = append(conditions, []string{"-" + key})
+		}
+		if in := q["in"].([]interface{}); len(in) > 0 {
+			var c []string
+			for j, v := range in {
+				c = append(c, key+"="+v.(string))
+				if j > 0 {
+					c = append(c, "#|")
+				}
+			}
+			conditions = append(conditions, c)
+		}
+
+		not, or := q["not"].(bool), q["or"].(bool)
+		if or && i == 0 {
+			return nil, nil, fmt.Errorf("the first query '%v' can not be joined by 'or'", q["key"])
+		}
+
+		if v := q["regex"].(string); v != "" {
+			if not || or {
+				return nil, nil, fmt.Errorf("the regular expression of the query '%v' can not be used with "+
+					"'not' or 'or'", q["key"])
+			}
+
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return nil, nil, fmt.Errorf("wrong regular expression of the query '%v': %v", q["key"], err)
+			}
+			if patterns == nil {
+				patterns = map[string]*regexp.Regexp{}
+			}
+			patterns[key] = re
+		} else if len(conditions) == 0 {
+			return nil, nil, fmt.Errorf("the query '%v' has no condition", q["key"])
+		}
+
+		if len(conditions) == 0 {
+			continue
+		}
+
+		for j, c := range conditions {
+			words = append(words, c...)
+			if j > 0 {
+				words = append(words, "#&")
+			}
+		}
+		if not {
+			words = append(words, "#!")
+		}
+		if or {
+			words = append(words, "#|")
+		}
+	}
+
+	return words, patterns, nil
+}
+
+// readItemsQuery Reads the items by the equality filter and the query words, then the items that do not match
+// the regular expressions are removed.
+func readItemsQuery(path string, filter, words []string, patterns map[string]*regexp.Regexp, c Client,
+	proplist ...string) (*[]MikrotikItem, error) {
+
+	if len(proplist) > 0 {
+		for key := range patterns {
+			if !slices.Contains(proplist, key) {
+				proplist = append(proplist, key)
+			}
+		}
+	}
+
+	var res *[]MikrotikItem
+	var err error
+	// Only the equality filter is supported by the REST GET requests.
+	if len(words) == 0 {
+		res, err = ReadItemsFiltered(filter, path, c, proplist...)
+	} else {
+		res, err = readItemsWhere(path, append(filter, words...), proplist, c)
+	}
+	if err != nil || len(patterns) == 0 {
+		return res, err
+	}
+
+	items := slices.DeleteFunc(*res, func(item MikrotikItem) bool {
+		for key, re := range patterns {
+			if !re.MatchString(item[key]) {
+				return true
+			}
+		}
+		return false
+	})
+
+	return &items, nil
+}
@@ -0,0 +1,95 @@
+package routeros
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+// testQuery Returns the query block with the empty attributes as it is read from the configuration.
+func testQuery(attributes map[string]interface{}) map[string]interface{} {
+	q := map[string]interface{}{"key": "", "equal": "", "not_equal": "", "less": "", "greater": "", "regex": "",
+		"present": false, "absent": false, "in": []interface{}{}, "not": false, "or": false}
+	for k, v := range attributes {
+		q[k] = v
+	}
+	return q
+}
+
+func TestBuildQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     []interface{}
+		wantWords []string
+		wantErr   bool
+	}{
+		{"Conditions", []interface{}{
+			testQuery(map[string]interface{}{"key": "mtu", "greater": "1500"}),
+			testQuery(map[string]interface{}{"key": "comment", "not_equal": "test"}),
+			testQuery(map[string]interface{}{"key": "mac_address", "present": true}),
+		}, []string{">mtu=1500", "comment=test", "#!", "mac-address"}, false},
+		{"Regex", []interface{}{
+			testQuery(map[string]interface{}{"key": "name", "regex": "^ether"}),
+		}, nil, false},
+		{"In", []interface{}{
+			testQuery(map[string]interface{}{"key": "interface", "in": []interface{}{"ether1", "ether2", "ether3"}}),
+		}, []string{"interface=ether1", "interface=ether2", "#|", "interface=ether3", "#|"}, false},
+		{"Or and not", []interface{}{
+			testQuery(map[string]interface{}{"key": "type", "equal": "ether"}),
+			testQuery(map[string]interface{}{"key": "mtu", "less": "9000", "greater": "1500", "not": true}),
+			testQuery(map[string]interface{}{"key": "comment", "absent": true, "or": true}),
+		}, []string{"type=ether", "<mtu=9000", ">mtu=1500", "#&", "#!", "-comment", "#|"}, false},
+		{"No conditions", []interface{}{testQuery(map[string]interface{}{"key": "name"})}, nil, true},
+		{"First or", []interface{}{testQuery(map[string]interface{}{"key": "name", "present": true, "or": true})},
+			nil, true},
+		{"Regex or", []interface{}{
+			testQuery(map[string]interface{}{"key": "name", "present": true}),
+			testQuery(map[string]interface{}{"key": "name", "regex": "^ether", "or": true}),
+		}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words, patterns, err := buildQuery(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(words, tt.wantWords) {
+				t.Errorf("buildQuery() words = %v, want %v", words, tt.wantWords)
+			}
+			if tt.name == "Regex" {
+				if re, ok := patterns["name"]; !ok || re.String() != "^ether" {
+					t.Errorf("buildQuery() patterns = %v", patterns)
+				}
+			}
+		})
+	}
+}
+
+type testQueryClient struct {
+	testWhereClient
+	items []MikrotikItem
+}
+
+func (c *testQueryClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	c.method, c.url, c.item = method, url, item
+	*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	return nil
+}
+
+func TestReadItemsQuery(t *testing.T) {
+	c := &testQueryClient{items: []MikrotikItem{{"name": "ether1"}, {"name": "bridge"}, {"name": "ether2"}}}
+	c.transport = TransportREST
+
+	res, err := readItemsQuery("/interface", []string{"type=ether"}, []string{">mtu=1500"},
+		map[string]*regexp.Regexp{"name": regexp.MustCompile("^ether")}, c, "mtu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []MikrotikItem{{"name": "ether1"}, {"name": "ether2"}}; !reflect.DeepEqual(*res, want) {
+		t.Errorf("readItemsQuery() = %v, want %v", *res, want)
+	}
+	if c.method != crudPrint || !reflect.DeepEqual(c.url.Query, []string{"type=ether", ">mtu=1500"}) ||
+		c.item[".proplist"] != "mtu,name" {
+		t.Errorf("wrong request: %v %v %v", c.method, c.url, c.item)
+	}
+}
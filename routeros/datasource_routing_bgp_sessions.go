@@ -0,0 +1,186 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceRoutingBgpSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRoutingBgpSessionsRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/routing/bgp/session"),
+			MetaId:           PropId(Id),
+			MetaTransformSet: PropTransformSet(
+				"input-last-notification: input.last-notification",
+				"input-procid: input.procid",
+				"local-address: local.address",
+				"local-as: local.as",
+				"local-bytes: local.bytes",
+				"local-capabilities: local.cap",
+				"local-eor: local.eor",
+				"local-id: local.id",
+				"local-messages: local.messages",
+				"local-role: local.role",
+				"output-procid: output.procid",
+				"remote-address: remote.address",
+				"remote-afi: remote.afi",
+				"remote-as: remote.as",
+				"remote-bytes: remote.bytes",
+				"remote-capabilities: remote.cap",
+				"remote-eor: remote.eor",
+				"remote-id: remote.id",
+				"remote-messages: remote.messages",
+			),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ebgp": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"established": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hold_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inactive": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"input_last_notification": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"input_procid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"keepalive_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_started": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_stopped": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_as": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"local_capabilities": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_eor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_messages": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"local_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multihop": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_procid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"prefix_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remote_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_afi": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_as": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remote_capabilities": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_eor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"remote_messages": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceRoutingBgpSessionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceRoutingBgpSessions().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceRoutingBgpSessions = "data.routeros_routing_bgp_sessions.data"
+
+func TestAccDatasourceRoutingBgpSessionsTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceRoutingBgpSessionsConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceRoutingBgpSessions),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceRoutingBgpSessionsConfig() string {
+	return providerConfig + `
+
+data "routeros_routing_bgp_sessions" "data" {}
+`
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceRoutingOspfNeighbors = "data.routeros_routing_ospf_neighbors.data"
+
+func TestAccDatasourceRoutingOspfNeighborsTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceRoutingOspfNeighborsConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceRoutingOspfNeighbors),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceRoutingOspfNeighborsConfig() string {
+	return providerConfig + `
+
+data "routeros_routing_ospf_neighbors" "data" {}
+`
+}
@@ -0,0 +1,50 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceSystemLicense() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/license"),
+		MetaId:           PropId(Id),
+
+		"deadline_at": { // CHR only
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"features": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"level": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"limited_upload": { // CHR only
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"next_renewal_at": { // CHR only
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"nlevel": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"software_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"system_id": { // CHR only
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: DefaultSystemDatasourceRead(resSchema),
+		Schema:      resSchema,
+	}
+}
@@ -0,0 +1,119 @@
+package routeros
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DatasourceSystemLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceSystemLogsRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/log"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Return only the latest N matching entries.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"message_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A regular expression that the log messages must match.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"topics": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Return only the entries that have all of the listed topics.",
+			},
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"buffer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topics": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceSystemLogsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceSystemLogs().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API can only match whole values, so topics and messages are filtered on our side.
+	var re *regexp.Regexp
+	if v, ok := d.GetOk("message_regex"); ok {
+		re = regexp.MustCompile(v.(string))
+	}
+
+	var entries []MikrotikItem
+	for _, item := range *res {
+		if re != nil && !re.MatchString(item["message"]) {
+			continue
+		}
+		if !logHasTopics(item["topics"], d.Get("topics").(*schema.Set).List()) {
+			continue
+		}
+		entries = append(entries, item)
+	}
+
+	if n := d.Get("max_count").(int); n > 0 && len(entries) > n {
+		entries = entries[len(entries)-n:]
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &entries, "data", s, d)
+}
+
+// logHasTopics Checks that the comma-separated list of entry topics contains all the required topics.
+func logHasTopics(topics string, required []interface{}) bool {
+	have := make(map[string]struct{})
+	for _, t := range strings.Split(topics, ",") {
+		have[t] = struct{}{}
+	}
+
+	for _, t := range required {
+		if _, ok := have[t.(string)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
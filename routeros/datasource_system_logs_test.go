@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceSystemLogs = "data.routeros_system_logs.data"
+
+func TestAccDatasourceSystemLogsTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceSystemLogsConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceSystemLogs),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceSystemLogsConfig() string {
+	return providerConfig + `
+
+data "routeros_system_logs" "data" {}
+`
+}
@@ -0,0 +1,39 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceSystemPackages = "data.routeros_system_packages.data"
+
+func TestAccDatasourceSystemPackagesTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceSystemPackagesConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceSystemPackages),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceSystemPackagesConfig() string {
+	return providerConfig + `
+
+data "routeros_system_packages" "data" {}
+`
+}
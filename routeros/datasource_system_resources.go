@@ -0,0 +1,162 @@
+package routeros
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The health sensors that are also available as the top level attributes.
+var systemResourcesHealthSensors = []string{"temperature", "cpu-temperature", "voltage"}
+
+// https://help.mikrotik.com/docs/display/ROS/Resource
+// https://help.mikrotik.com/docs/display/ROS/RouterBOARD
+func DatasourceSystemResources() *schema.Resource {
+	computed := func(t schema.ValueType, description string) *schema.Schema {
+		return &schema.Schema{Type: t, Computed: true, Description: description}
+	}
+
+	return &schema.Resource{
+		Description: "Combines /system/resource, /system/routerboard and /system/health in one data source: the " +
+			"hardware model, the serial number, the firmware, the CPU load, the memory and the health sensors.",
+		ReadContext: datasourceSystemResourcesRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/system/resource"),
+			MetaId:           PropId(Id),
+
+			// /system/resource
+			"architecture_name": computed(schema.TypeString, ""),
+			"board_name":        computed(schema.TypeString, ""),
+			"build_time":        computed(schema.TypeString, ""),
+			"cpu":               computed(schema.TypeString, ""),
+			"cpu_count":         computed(schema.TypeInt, ""),
+			"cpu_frequency":     computed(schema.TypeInt, "CPU frequency in MHz."),
+			"cpu_load":          computed(schema.TypeInt, "CPU load in percent."),
+			"factory_software":  computed(schema.TypeString, ""),
+			"free_hdd_space":    computed(schema.TypeInt, ""),
+			"free_memory":       computed(schema.TypeInt, ""),
+			"platform":          computed(schema.TypeString, ""),
+			"total_hdd_space":   computed(schema.TypeInt, ""),
+			"total_memory":      computed(schema.TypeInt, ""),
+			"uptime":            computed(schema.TypeString, ""),
+			"version":           computed(schema.TypeString, ""),
+			// /system/routerboard
+			"current_firmware": computed(schema.TypeString, ""),
+			"factory_firmware": computed(schema.TypeString, ""),
+			"firmware_type":    computed(schema.TypeString, ""),
+			"model":            computed(schema.TypeString, "The hardware model, empty on CHR."),
+			"revision":         computed(schema.TypeString, ""),
+			"routerboard":      computed(schema.TypeBool, "The device is a RouterBOARD."),
+			"serial_number":    computed(schema.TypeString, ""),
+			"upgrade_firmware": computed(schema.TypeString, ""),
+			// /system/health
+			"cpu_temperature": computed(schema.TypeString, "The 'cpu-temperature' health sensor, empty if the "+
+				"device does not have it."),
+			"temperature": computed(schema.TypeString, "The 'temperature' health sensor, empty if the device "+
+				"does not have it."),
+			"voltage": computed(schema.TypeString, "The 'voltage' health sensor, empty if the device does not "+
+				"have it."),
+			"health": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All health sensors of the device.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceSystemResourcesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceSystemResources().Schema
+	c := m.(Client)
+
+	// The fields that are added by the newer RouterOS versions are skipped instead of the warnings.
+	res := MikrotikItem{}
+	for _, path := range []string{"/system/resource", "/system/routerboard"} {
+		item := MikrotikItem{}
+		if err := c.SendRequest(crudRead, &URL{Path: path}, nil, &item); err != nil {
+			return diag.FromErr(err)
+		}
+		for k, v := range item {
+			if _, ok := s[KebabToSnake(k)]; ok {
+				res[k] = v
+			}
+		}
+	}
+
+	items, err := ReadItems(nil, "/system/health", c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sensors := systemHealthSensors(*items)
+	health := make([]interface{}, 0, len(sensors))
+	for _, sensor := range sensors {
+		health = append(health, map[string]interface{}{
+			"name":  sensor["name"],
+			"type":  sensor["type"],
+			"value": sensor["value"],
+		})
+	}
+	for _, name := range systemResourcesHealthSensors {
+		res[name] = ""
+		for _, sensor := range sensors {
+			if sensor["name"] == name {
+				res[name] = sensor["value"]
+			}
+		}
+	}
+
+	diags := MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &[]MikrotikItem{res}, "", s, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err = d.Set("health", health); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// systemHealthSensors Returns the health sensors as name, value and type. RouterOS 7 returns one item per sensor,
+// RouterOS 6 returns one item with all sensors as its fields.
+func systemHealthSensors(items []MikrotikItem) []MikrotikItem {
+	var res []MikrotikItem
+	for _, item := range items {
+		if name, ok := item["name"]; ok {
+			res = append(res, MikrotikItem{"name": name, "value": item["value"], "type": item["type"]})
+			continue
+		}
+
+		var names []string
+		for k := range item {
+			if k[0:1] != "." {
+				names = append(names, k)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			res = append(res, MikrotikItem{"name": name, "value": item[name]})
+		}
+	}
+	return res
+}
@@ -0,0 +1,108 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceSystemResources = "data.routeros_system_resources.data"
+
+func TestAccDatasourceSystemResourcesTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceSystemResourcesConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceSystemResources),
+							resource.TestCheckResourceAttrSet(testDatasourceSystemResources, "version"),
+							resource.TestCheckResourceAttrSet(testDatasourceSystemResources, "routerboard"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceSystemResourcesConfig() string {
+	return providerConfig + `
+
+data "routeros_system_resources" "data" {}
+`
+}
+
+type testSystemResourcesClient struct {
+	testOperationClient
+	health []MikrotikItem
+}
+
+func (c *testSystemResourcesClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch url.Path {
+	case "/system/resource":
+		*result.(*MikrotikItem) = MikrotikItem{"board-name": "hAP ax^2", "cpu-load": "3", "version": "7.16 (stable)",
+			"new-field": "skipped"}
+	case "/system/routerboard":
+		*result.(*MikrotikItem) = MikrotikItem{"model": "C52iG-5HaxD2HaxD", "routerboard": "true",
+			"serial-number": "HE10000000", "current-firmware": "7.16"}
+	case "/system/health":
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.health...)
+	}
+	return nil
+}
+
+func TestDatasourceSystemResourcesRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		health      []MikrotikItem
+		temperature string
+		voltage     string
+		sensors     []interface{}
+	}{
+		{"RouterOS 7", []MikrotikItem{
+			{".id": "*D", "name": "cpu-temperature", "value": "48", "type": "C"},
+			{".id": "*E", "name": "voltage", "value": "24.1", "type": "V"},
+		}, "", "24.1", []interface{}{
+			map[string]interface{}{"name": "cpu-temperature", "value": "48", "type": "C"},
+			map[string]interface{}{"name": "voltage", "value": "24.1", "type": "V"},
+		}},
+		{"RouterOS 6", []MikrotikItem{{"voltage": "24", "temperature": "36"}}, "36", "24", []interface{}{
+			map[string]interface{}{"name": "temperature", "value": "36", "type": ""},
+			map[string]interface{}{"name": "voltage", "value": "24", "type": ""},
+		}},
+		{"CHR", nil, "", "", []interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := DatasourceSystemResources()
+			d := r.TestResourceData()
+
+			diags := r.ReadContext(context.Background(), d, &testSystemResourcesClient{health: tt.health})
+			if len(diags) > 0 {
+				t.Fatal(diags)
+			}
+
+			if d.Get("model") != "C52iG-5HaxD2HaxD" || d.Get("serial_number") != "HE10000000" ||
+				d.Get("routerboard") != true || d.Get("cpu_load") != 3 || d.Get("board_name") != "hAP ax^2" {
+				t.Errorf("wrong resource attributes: %v", d.State().Attributes)
+			}
+			if d.Get("temperature") != tt.temperature || d.Get("voltage") != tt.voltage {
+				t.Errorf("temperature = %v, voltage = %v, want %v, %v", d.Get("temperature"), d.Get("voltage"),
+					tt.temperature, tt.voltage)
+			}
+			if got := d.Get("health").([]interface{}); !reflect.DeepEqual(got, tt.sensors) {
+				t.Errorf("health = %v, want %v", got, tt.sensors)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+  "channel": "stable",
+  "installed-version": "7.19.1",
+  "latest-version": "7.19.2",
+  "status": "New version is available"
+}
+*/
+
+func DatasourceSystemUpdate() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/package/update"),
+		MetaId:           PropId(Id),
+
+		"channel": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"check_for_updates": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Ask the upgrade server for the latest version of the selected channel before reading the state.",
+		},
+		"installed_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"latest_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if d.Get("check_for_updates").(bool) {
+				var resUrl = &URL{
+					Path: resSchema[MetaResourcePath].Default.(string),
+				}
+				if m.(Client).GetTransport() == TransportREST {
+					resUrl.Path += "/check-for-updates"
+				}
+
+				// Without 'once' the command keeps reporting the status until it is cancelled.
+				err := m.(Client).SendRequest(crudCheckForUpdates, resUrl, MikrotikItem{"once": ""}, nil)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			return DefaultSystemDatasourceRead(resSchema)(ctx, d, m)
+		},
+		Schema: resSchema,
+	}
+}
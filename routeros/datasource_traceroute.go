@@ -0,0 +1,150 @@
+package routeros
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+  ".section": "2",
+  "address": "10.0.0.1",
+  "avg": "0.4",
+  "best": "0.3",
+  "last": "0.4",
+  "loss": "0",
+  "sent": "3",
+  "status": "",
+  "std-dev": "0",
+  "worst": "0.5"
+}
+*/
+
+func DatasourceTraceroute() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceTracerouteRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/tool/traceroute"),
+			MetaId:           PropId(Id),
+
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address or host name to trace the route to.",
+			},
+			"count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				Description:  "Number of probes sent to every hop.",
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"max_hops": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of hops.",
+				ValidateFunc: validation.IntBetween(1, 255),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The protocol of the probes.",
+				ValidateFunc: validation.StringInSlice([]string{"icmp", "udp"}, false),
+			},
+			"src_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The source address of the probes.",
+			},
+			"vrf": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The VRF to trace the route in.",
+			},
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"avg": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"best": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"loss": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"std_dev": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"worst": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceTracerouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceTraceroute().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	item := MikrotikItem{
+		"address": d.Get("address").(string),
+		"count":   strconv.Itoa(d.Get("count").(int)),
+	}
+	for _, field := range []string{"protocol", "src_address", "vrf"} {
+		if v, ok := d.GetOk(field); ok {
+			item[SnakeToKebab(field)] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("max_hops"); ok {
+		item["max-hops"] = strconv.Itoa(v.(int))
+	}
+
+	res := &[]MikrotikItem{}
+	if err := m.(Client).SendRequest(crudExecute, &URL{Path: path}, item, res); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The hop table is reported again after every round of probes, keep only the last one.
+	var hops []MikrotikItem
+	var section string
+	for _, hop := range *res {
+		if hop[".section"] != section {
+			section = hop[".section"]
+			hops = nil
+		}
+		hops = append(hops, hop)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &hops, "data", s, d)
+}
@@ -0,0 +1,124 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceTraceroute = "data.routeros_traceroute.data"
+
+func TestAccDatasourceTracerouteTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceTracerouteConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceTraceroute),
+							resource.TestCheckResourceAttr(testDatasourceTraceroute, "data.#", "1"),
+							resource.TestCheckResourceAttr(testDatasourceTraceroute, "data.0.address", "127.0.0.1"),
+							resource.TestCheckResourceAttr(testDatasourceTraceroute, "data.0.sent", "2"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceTracerouteConfig() string {
+	return providerConfig + `
+
+data "routeros_traceroute" "data" {
+  address = "127.0.0.1"
+  count   = 2
+}
+`
+}
+
+type testTracerouteClient struct {
+	testOperationClient
+	rows []MikrotikItem
+	sent MikrotikItem
+}
+
+func (c *testTracerouteClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	if method == crudExecute && url.Path == "/tool/traceroute" {
+		c.sent = item
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.rows...)
+	}
+	return nil
+}
+
+func TestDatasourceTracerouteRead(t *testing.T) {
+	hop := func(section, address, sent, status string) MikrotikItem {
+		return MikrotikItem{".section": section, "address": address, "sent": sent, "loss": "0", "status": status}
+	}
+	row := func(address, sent, loss, status string) map[string]interface{} {
+		return map[string]interface{}{"address": address, "avg": "", "best": "", "last": "", "loss": loss,
+			"sent": sent, "status": status, "std_dev": "", "worst": ""}
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		rows   []MikrotikItem
+		sent   MikrotikItem
+		want   []interface{}
+	}{
+		{
+			"Last round",
+			map[string]interface{}{"address": "192.0.2.1"},
+			[]MikrotikItem{
+				hop("0", "10.0.0.1", "1", ""),
+				hop("1", "10.0.0.1", "2", ""),
+				hop("1", "192.0.2.1", "1", ""),
+				hop("2", "10.0.0.1", "3", ""),
+				hop("2", "192.0.2.1", "3", ""),
+			},
+			MikrotikItem{"address": "192.0.2.1", "count": "3"},
+			[]interface{}{row("10.0.0.1", "3", "0", ""), row("192.0.2.1", "3", "0", "")},
+		},
+		{
+			"No sections",
+			map[string]interface{}{"address": "192.0.2.1", "count": 1, "max_hops": 5, "protocol": "udp",
+				"src_address": "10.0.0.2"},
+			[]MikrotikItem{
+				{"address": "10.0.0.1", "sent": "1", "loss": "0"},
+				{"address": "", "sent": "1", "loss": "100", "status": "timeout"},
+			},
+			MikrotikItem{"address": "192.0.2.1", "count": "1", "max-hops": "5", "protocol": "udp",
+				"src-address": "10.0.0.2"},
+			[]interface{}{row("10.0.0.1", "1", "0", ""), row("", "1", "100", "timeout")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := DatasourceTraceroute()
+			d := schema.TestResourceDataRaw(t, r.Schema, tt.config)
+
+			c := &testTracerouteClient{rows: tt.rows}
+			if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			if !reflect.DeepEqual(c.sent, tt.sent) {
+				t.Errorf("sent = %v, want %v", c.sent, tt.sent)
+			}
+			if got := d.Get("data").([]interface{}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("data = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceWifiRegistration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceWifiRegistrationRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/interface/wifi/registration-table"),
+			MetaId:           PropId(Id),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"band": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_activity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packets": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rx_bits_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rx_rate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signal": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ssid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tx_bits_per_second": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tx_rate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vlan_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceWifiRegistrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceWifiRegistration().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
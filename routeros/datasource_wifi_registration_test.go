@@ -0,0 +1,44 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceWifiRegistration = "data.routeros_wifi_registration.data"
+
+func TestAccDatasourceWifiRegistrationTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/interface/wifi") {
+		t.Logf("Test skipped, the router does not have the wifi package")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceWifiRegistrationConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceWifiRegistration),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceWifiRegistrationConfig() string {
+	return providerConfig + `
+
+data "routeros_wifi_registration" "data" {}
+`
+}
@@ -0,0 +1,186 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DatasourceWirelessRegistration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceWirelessRegistrationRead,
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/interface/wireless/registration-table"),
+			MetaId:           PropId(Id),
+			MetaSkipFields:   PropSkipFields("802.1x_port_enabled"),
+
+			KeyFilter: PropFilterRw,
+			KeyQuery:  PropQueryRw,
+			"data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ap": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"authentication_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bridge": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compression": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"distance": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"encryption": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frame_bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frames": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_encryption": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hw_frame_bytes": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hw_frames": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interface": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_activity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"management_protection": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"p_throughput": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"packets": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routeros_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rx_rate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signal_strength": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signal_strength_ch0": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"signal_strength_ch1": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"signal_to_noise": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tx_ccq": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tx_frames_timed_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tx_rate": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tx_signal_strength": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tx_signal_strength_ch0": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tx_signal_strength_ch1": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"uptime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"wds": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"wmm_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceWirelessRegistrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s := DatasourceWirelessRegistration().Schema
+	path := s[MetaResourcePath].Default.(string)
+
+	res, err := readDatasourceItems(d, path, m.(Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), res, "data", s, d)
+}
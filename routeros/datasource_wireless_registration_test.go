@@ -0,0 +1,44 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDatasourceWirelessRegistration = "data.routeros_wireless_registration.data"
+
+func TestAccDatasourceWirelessRegistrationTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/interface/wireless") {
+		t.Logf("Test skipped, the router does not have the wireless package")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccDatasourceWirelessRegistrationConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testDatasourceWirelessRegistration),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccDatasourceWirelessRegistrationConfig() string {
+	return providerConfig + `
+
+data "routeros_wireless_registration" "data" {}
+`
+}
@@ -0,0 +1,158 @@
+package routeros
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// EphemeralWireguardKeys Generates a WireGuard key pair (Terraform 1.10+), the private key is not stored
+// in the plan or state.
+func EphemeralWireguardKeys() ephemeralResource {
+	return ephemeralResource{
+		schema: &tfprotov5.Schema{Block: &tfprotov5.SchemaBlock{
+			Description: "Generates a WireGuard key pair without storing the private key in the state. " +
+				"The keys are generated locally or, with `on_router`, by the router.",
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{
+					Name:            "on_router",
+					Type:            tftypes.Bool,
+					Optional:        true,
+					Description:     "Generate the key pair on the router: a temporary WireGuard interface is created and removed.",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				{
+					Name:            "private_key",
+					Type:            tftypes.String,
+					Computed:        true,
+					Sensitive:       true,
+					Description:     "Private WG key.",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				{
+					Name:            "public_key",
+					Type:            tftypes.String,
+					Computed:        true,
+					Description:     "Public WG key.",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+				{
+					Name:            "preshared_key",
+					Type:            tftypes.String,
+					Computed:        true,
+					Sensitive:       true,
+					Description:     "Pre-shared secret key, it is always generated locally.",
+					DescriptionKind: tfprotov5.StringKindMarkdown,
+				},
+			},
+		}},
+		open: wgKeysOpen,
+	}
+}
+
+func wgKeysOpen(ctx context.Context, config tftypes.Value, m interface{}) (tftypes.Value, error) {
+	var attrs map[string]tftypes.Value
+	if err := config.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	var onRouter bool
+	if v := attrs["on_router"]; v.IsKnown() && !v.IsNull() {
+		if err := v.As(&onRouter); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+
+	var private, public string
+	if onRouter {
+		c, ok := m.(Client)
+		if !ok {
+			return tftypes.Value{}, errors.New("the provider is not configured")
+		}
+
+		var err error
+		if private, public, err = wgKeysOnRouter(ctx, c); err != nil {
+			return tftypes.Value{}, err
+		}
+	} else {
+		key, err := GeneratePrivateKey()
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		private, public = key.String(), key.PublicKey().String()
+	}
+
+	psk, err := GenerateKey()
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(config.Type(), map[string]tftypes.Value{
+		"on_router":     tftypes.NewValue(tftypes.Bool, onRouter),
+		"private_key":   tftypes.NewValue(tftypes.String, private),
+		"public_key":    tftypes.NewValue(tftypes.String, public),
+		"preshared_key": tftypes.NewValue(tftypes.String, psk.String()),
+	}), nil
+}
+
+// wgKeysOnRouter Returns the keys of a temporary WireGuard interface.
+func wgKeysOnRouter(ctx context.Context, c Client) (private, public string, err error) {
+	const path = "/interface/wireguard"
+
+	b := make([]byte, 4)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	name := "terraform-keygen-" + hex.EncodeToString(b)
+
+	res, err := CreateItem(ctx, MikrotikItem{"name": name, "disabled": "yes"}, path, c)
+	if err != nil {
+		return
+	}
+
+	// The temporary interface holds the private key, it is removed whatever happens next.
+	defer func() {
+		if e := removeWgKeysInterface(res.GetID(Id), name, path, c); e != nil {
+			ColorizedMessage(ctx, WARN, "The temporary WireGuard interface is not removed",
+				map[string]interface{}{"name": name, "error": e})
+			if err == nil {
+				private, public = "", ""
+				err = fmt.Errorf("failed to remove the temporary WireGuard interface '%v': %w", name, e)
+			}
+		}
+	}()
+
+	items, err := ReadItems(&ItemId{Name, name}, path, c)
+	if err == nil && (items == nil || len(*items) != 1) {
+		err = fmt.Errorf("the temporary WireGuard interface '%v' was not found", name)
+	}
+	if err != nil {
+		return
+	}
+	iface := (*items)[0]
+
+	return iface["private-key"], iface["public-key"], nil
+}
+
+// removeWgKeysInterface Removes the temporary interface by the ID of the create or, if the ID is not returned,
+// by its name.
+func removeWgKeysInterface(id, name, path string, c Client) error {
+	if id == "" {
+		items, err := ReadItems(&ItemId{Name, name}, path, c, ".id")
+		if err != nil {
+			return err
+		}
+		if items == nil || len(*items) == 0 {
+			return nil
+		}
+		id = (*items)[0].GetID(Id)
+	}
+
+	return DeleteItem(&ItemId{Id, id}, path, c)
+}
@@ -0,0 +1,134 @@
+package routeros
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testWgKeysClient struct {
+	testOperationClient
+	methods []crudMethod
+	// The create does not return the ID.
+	noId bool
+	// The read of the keys fails.
+	readErr error
+	deleted []string
+}
+
+func (c *testWgKeysClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	c.methods = append(c.methods, method)
+	switch method {
+	case crudCreate:
+		if !c.noId {
+			(*result.(*MikrotikItem))[".id"] = "*9"
+		}
+	case crudRead:
+		if c.readErr != nil {
+			return c.readErr
+		}
+		*result.(*[]MikrotikItem) = []MikrotikItem{{".id": "*9", "private-key": "private", "public-key": "public"}}
+	case crudDelete:
+		c.deleted = append(c.deleted, url.Path)
+	}
+	return nil
+}
+
+func openWgKeys(t *testing.T, onRouter bool, m interface{}) map[string]string {
+	r := ephemeralResources["routeros_wireguard_keys"]
+	typ := r.schema.ValueType()
+
+	s := &protocolServer{provider: Provider()}
+	s.provider.SetMeta(m)
+
+	config, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"on_router":     tftypes.NewValue(tftypes.Bool, onRouter),
+		"private_key":   tftypes.NewValue(tftypes.String, nil),
+		"public_key":    tftypes.NewValue(tftypes.String, nil),
+		"preshared_key": tftypes.NewValue(tftypes.String, nil),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "routeros_wireguard_keys",
+		Config:   &config,
+	})
+	if err != nil || len(resp.Diagnostics) > 0 {
+		t.Fatalf("OpenEphemeralResource() error = %v, diagnostics = %v", err, resp.Diagnostics)
+	}
+
+	v, err := resp.Result.Unmarshal(typ)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err = v.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+
+	res := map[string]string{}
+	for _, k := range []string{"private_key", "public_key", "preshared_key"} {
+		var s string
+		if err = attrs[k].As(&s); err != nil {
+			t.Fatal(err)
+		}
+		res[k] = s
+	}
+	return res
+}
+
+func TestEphemeralWireguardKeys(t *testing.T) {
+	keys := openWgKeys(t, false, nil)
+
+	b, err := base64.StdEncoding.DecodeString(keys["private_key"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	private, err := NewKey(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys["public_key"] != private.PublicKey().String() || keys["preshared_key"] == "" {
+		t.Errorf("wrong local keys: %v", keys)
+	}
+
+	c := &testWgKeysClient{}
+	keys = openWgKeys(t, true, c)
+	if keys["private_key"] != "private" || keys["public_key"] != "public" {
+		t.Errorf("wrong router keys: %v", keys)
+	}
+	if len(c.methods) != 3 || c.methods[0] != crudCreate || c.methods[2] != crudDelete {
+		t.Errorf("the temporary interface is not created and removed: %v", c.methods)
+	}
+}
+
+func TestWgKeysOnRouterCleanup(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  *testWgKeysClient
+		wantErr bool
+	}{
+		{"Keys", &testWgKeysClient{}, false},
+		{"Read failed", &testWgKeysClient{readErr: errors.New("no such command")}, true},
+		{"Create without ID", &testWgKeysClient{noId: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := wgKeysOnRouter(context.Background(), tt.client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wgKeysOnRouter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(tt.client.deleted, []string{"/interface/wireguard/*9"}) {
+				t.Errorf("deleted = %v, the temporary interface is not removed", tt.client.deleted)
+			}
+		})
+	}
+}
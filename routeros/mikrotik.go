@@ -6,8 +6,21 @@ type TransportType int
 const (
 	TransportAPI TransportType = 1 + iota
 	TransportREST
+	TransportSSH
 )
 
+func (t TransportType) String() string {
+	switch t {
+	case TransportAPI:
+		return "api"
+	case TransportREST:
+		return "rest"
+	case TransportSSH:
+		return "ssh"
+	}
+	return "error: undefined transport type"
+}
+
 type IdType int
 
 const (
@@ -0,0 +1,108 @@
+package routeros
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Attributes with sensitive values.
+var reAuditSensitive = regexp.MustCompile(`(?i)(password|passphrase|secret|private-key|preshared-key|key|token|psk)`)
+
+// Attributes with the scripts: /execute, /system/script, the scheduler and the netwatch scripts often contain
+// credentials.
+var reAuditScript = regexp.MustCompile(`(?i)^(script|source|on-event|on-up|on-down|up-script|down-script|test-script)$`)
+
+const auditMaskedValue = "***"
+
+// AuditLog Writes a JSON Lines record of every request sent to the router.
+type AuditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+type auditRecord struct {
+	Time      string `json:"time"`
+	Transport string `json:"transport"`
+	Method    string `json:"method"`
+	// The HTTP method and the response status code of the REST requests.
+	HTTPMethod string            `json:"http_method,omitempty"`
+	Path       string            `json:"path"`
+	Query      []string          `json:"query,omitempty"`
+	Payload    map[string]string `json:"payload,omitempty"`
+	Status     string            `json:"status"`
+	StatusCode int               `json:"status_code,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+type requestStatusKey struct{}
+
+// withRequestStatus Returns the context that receives the response status code of the request.
+func withRequestStatus(ctx context.Context) (context.Context, *int) {
+	code := new(int)
+	return context.WithValue(ctx, requestStatusKey{}, code), code
+}
+
+// setRequestStatus Stores the response status code of the REST request, the last attempt of a repeated request wins.
+func setRequestStatus(ctx context.Context, code int) {
+	if p, ok := ctx.Value(requestStatusKey{}).(*int); ok {
+		*p = code
+	}
+}
+
+func NewAuditLog(path string) (*AuditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLog{file: f}, nil
+}
+
+func (l *AuditLog) Write(ctx context.Context, transport TransportType, method crudMethod, url *URL,
+	item MikrotikItem, statusCode int, err error) {
+
+	rec := auditRecord{
+		Time:       time.Now().UTC().Format(time.RFC3339Nano),
+		Transport:  transport.String(),
+		Method:     method.String(),
+		Path:       url.Path,
+		Query:      url.Query,
+		Status:     "ok",
+		StatusCode: statusCode,
+	}
+	if transport == TransportREST {
+		rec.HTTPMethod = restMethodName[method]
+	}
+
+	if len(item) > 0 {
+		rec.Payload = make(map[string]string, len(item))
+		for k, v := range item {
+			if reAuditSensitive.MatchString(k) || (reAuditScript.MatchString(k) && v != "") {
+				v = auditMaskedValue
+			}
+			rec.Payload[k] = v
+		}
+	}
+
+	if err != nil {
+		rec.Status = "error"
+		rec.Error = err.Error()
+	}
+
+	b, e := json.Marshal(rec)
+	if e != nil {
+		ColorizedDebug(ctx, "Failed to marshal the audit log record: "+e.Error())
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, e = l.file.Write(append(b, '\n')); e != nil {
+		ColorizedMessage(ctx, WARN, "Failed to write the audit log: "+e.Error())
+	}
+}
@@ -0,0 +1,100 @@
+package routeros
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAuditLog_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	l.Write(ctx, TransportREST, crudCreate, &URL{Path: "/user"}, MikrotikItem{"name": "test", "password": "secret"}, 200, nil)
+	l.Write(ctx, TransportAPI, crudDelete, &URL{Path: "/user", Query: []string{"=.id=*1"}}, nil, 0, errors.New("no such item"))
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("audit log contains %v records, want 2", len(lines))
+	}
+
+	var rec auditRecord
+	if err = json.Unmarshal([]byte(lines[0]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Transport != "rest" || rec.Method != "create" || rec.HTTPMethod != "PUT" || rec.Path != "/user" ||
+		rec.Status != "ok" || rec.StatusCode != 200 {
+		t.Errorf("wrong audit record: %v", lines[0])
+	}
+	if rec.Payload["name"] != "test" || rec.Payload["password"] != auditMaskedValue {
+		t.Errorf("wrong audit record payload: %v", rec.Payload)
+	}
+
+	rec = auditRecord{}
+	if err = json.Unmarshal([]byte(lines[1]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Transport != "api" || rec.Method != "delete" || rec.HTTPMethod != "" || rec.Status != "error" ||
+		rec.StatusCode != 0 || rec.Error != "no such item" {
+		t.Errorf("wrong audit record: %v", lines[1])
+	}
+}
+
+func TestAuditLog_WriteScript(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	l.Write(ctx, TransportREST, crudRunScript, &URL{Path: "/execute"},
+		MikrotikItem{"script": "/user/add name=test password=secret", "as-string": ""}, 200, nil)
+	l.Write(ctx, TransportAPI, crudCreate, &URL{Path: "/system/script"},
+		MikrotikItem{"name": "backup", "source": "/tool/fetch user=u password=p", "comment": "source"}, 0, nil)
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") || strings.Contains(string(b), "password=p") {
+		t.Fatalf("audit log contains the script: %s", b)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("audit log contains %v records, want 2", len(lines))
+	}
+
+	var rec auditRecord
+	if err = json.Unmarshal([]byte(lines[0]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Method != "run-script" || rec.HTTPMethod != "POST" || rec.Payload["script"] != auditMaskedValue ||
+		rec.Payload["as-string"] != "" {
+		t.Errorf("wrong audit record: %v", lines[0])
+	}
+
+	rec = auditRecord{}
+	if err = json.Unmarshal([]byte(lines[1]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Payload["source"] != auditMaskedValue || rec.Payload["name"] != "backup" || rec.Payload["comment"] != "source" {
+		t.Errorf("wrong audit record payload: %v", rec.Payload)
+	}
+}
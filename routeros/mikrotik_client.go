@@ -5,21 +5,28 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
-	"os"
 	"regexp"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/go-routeros/routeros/v3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 )
 
 type Client interface {
 	GetExtraParams() *ExtraParams
 	GetTransport() TransportType
+	GetRouterOSVersion() string
 	SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error
 }
 
@@ -41,18 +48,154 @@ const (
 	crudStart
 	crudStop
 	crudGenerateKey
+	crudActivate
+	crudEnable
+	crudDisable
+	crudApplyChanges
+	crudDeviceModeUpdate
+	crudCheckForUpdates
+	crudMonitor
+	crudExecute
+	crudPrint
+	crudRunScript
 )
 
+// crudMethodName The names of the methods in the errors and the logs.
+var crudMethodName = map[crudMethod]string{
+	crudCreate:           "create",
+	crudRead:             "read",
+	crudUpdate:           "update",
+	crudDelete:           "delete",
+	crudPost:             "post",
+	crudImport:           "import",
+	crudSign:             "sign",
+	crudSignViaScep:      "sign-via-scep",
+	crudRemove:           "remove",
+	crudRevoke:           "revoke",
+	crudMove:             "move",
+	crudStart:            "start",
+	crudStop:             "stop",
+	crudGenerateKey:      "generate-key",
+	crudActivate:         "activate",
+	crudEnable:           "enable",
+	crudDisable:          "disable",
+	crudApplyChanges:     "apply-changes",
+	crudDeviceModeUpdate: "device-mode-update",
+	crudCheckForUpdates:  "check-for-updates",
+	crudMonitor:          "monitor",
+	crudExecute:          "execute",
+	crudPrint:            "print",
+	crudRunScript:        "run-script",
+}
+
+func (m crudMethod) String() string {
+	if name, ok := crudMethodName[m]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 type ExtraParams struct {
 	SuppressSysODelWarn bool
+	MaxRetries          int
+	RetryBackoff        time.Duration
+	ReadOnly            bool
+	AuditLog            *AuditLog
+	Timeouts            map[string]time.Duration
+	ManagedComment      string
+	PageSize            int
+	RebootWaitTimeout   time.Duration
+	Metrics             *Metrics
+	ConflictCheck       string
+}
+
+// doRequest Sends the request of any transport: checks the read-only mode, repeats the request
+// on transient errors, records the tracing span and the metrics and writes the audit log record.
+func doRequest(ctx context.Context, extra *ExtraParams, transport TransportType, method crudMethod, url *URL,
+	item MikrotikItem, send func(ctx context.Context) error) error {
+
+	ctx, span := startRequestSpan(ctx, transport, method, url)
+	ctx, statusCode := withRequestStatus(ctx)
+	start := time.Now()
+
+	var attempts int
+	err := checkReadOnly(extra, method, url)
+	if err == nil {
+		err = sendWithRetry(ctx, extra, method, func() error {
+			attempts++
+			return send(ctx)
+		})
+	}
+
+	endRequestSpan(span, err)
+
+	if extra != nil && extra.Metrics != nil {
+		extra.Metrics.ObserveRequest(transport, method, url, time.Since(start), attempts, err)
+	}
+
+	if extra != nil && extra.AuditLog != nil {
+		extra.AuditLog.Write(ctx, transport, method, url, item, *statusCode, err)
+	}
+
+	return err
+}
+
+// Methods that do not change the router configuration. The scripts (crudRunScript) can change it and are not allowed,
+// crudExecute only runs the commands that read the state: ping, traceroute.
+var readOnlyMethods = map[crudMethod]struct{}{
+	crudRead:            {},
+	crudMonitor:         {},
+	crudExecute:         {},
+	crudPrint:           {},
+	crudCheckForUpdates: {},
+}
+
+// checkReadOnly Rejects requests that change the router configuration in the read-only mode.
+func checkReadOnly(extra *ExtraParams, method crudMethod, url *URL) error {
+	if extra == nil || !extra.ReadOnly {
+		return nil
+	}
+
+	if _, ok := readOnlyMethods[method]; ok {
+		return nil
+	}
+
+	return fmt.Errorf("the provider is in read-only mode, the '%v' request to '%v' is not allowed", method,
+		url.Path)
 }
 
 func NewClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 
+	if err := applyProfile(d); err != nil {
+		return nil, diag.Errorf("Failed to load the connection profile, %v", err)
+	}
+
+	creds, diags := getCredentials(ctx, d)
+	if diags != nil {
+		return nil, diags
+	}
+
 	tlsConf := tls.Config{
 		InsecureSkipVerify: d.Get("insecure").(bool),
 	}
 
+	if v := d.Get("tls_min_version").(string); v != "" {
+		tlsConf.MinVersion = tlsVersions[v]
+	}
+
+	if v := d.Get("tls_cipher_suites").([]interface{}); len(v) > 0 {
+		var names []string
+		for _, name := range v {
+			names = append(names, name.(string))
+		}
+
+		cipherSuites, err := tlsCipherSuites(names)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		tlsConf.CipherSuites = cipherSuites
+	}
+
 	caCertificate := d.Get("ca_certificate").(string)
 	if tlsConf.InsecureSkipVerify && caCertificate != "" {
 		return nil, diag.Errorf("You have selected mutually exclusive options: " +
@@ -60,135 +203,490 @@ func NewClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.D
 	}
 
 	if caCertificate != "" {
-		if _, err := os.Stat(caCertificate); err != nil {
-			ColorizedDebug(ctx, "Failed to read CA file '"+caCertificate+"', error: "+err.Error())
-			return nil, diag.FromErr(err)
-		}
-
 		certPool := x509.NewCertPool()
-		file, err := os.ReadFile(caCertificate)
+		file, err := readPem(caCertificate)
 		if err != nil {
 			ColorizedDebug(ctx, "Failed to read CA file '"+caCertificate+"', error: "+err.Error())
 			return nil, diag.Errorf("Failed to read CA file '%s', %v", caCertificate, err)
 		}
-		certPool.AppendCertsFromPEM(file)
+		if !certPool.AppendCertsFromPEM(file) {
+			return nil, diag.Errorf("No certificates found in the CA certificate")
+		}
 		tlsConf.RootCAs = certPool
 	}
 
-	routerUrl, err := url.Parse(d.Get("hosturl").(string))
-	if err != nil || routerUrl.Host == "" {
-		routerUrl, err = url.Parse("https://" + d.Get("hosturl").(string))
+	if fingerprint := d.Get("tls_fingerprint").(string); fingerprint != "" {
+		if caCertificate != "" {
+			return nil, diag.Errorf("You have selected mutually exclusive options: " +
+				"ca_certificate and tls_fingerprint. Please check the ENV variables and TF files.")
+		}
+
+		verify, err := tlsVerifyFingerprint(fingerprint)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		// The chain verification is replaced by the fingerprint check.
+		tlsConf.InsecureSkipVerify = true
+		tlsConf.VerifyPeerCertificate = verify
+	}
+
+	clientCertificate, clientKey := d.Get("tls_client_certificate").(string), d.Get("tls_client_key").(string)
+	if clientCertificate != "" || clientKey != "" {
+		if clientCertificate == "" || clientKey == "" {
+			return nil, diag.Errorf("Both tls_client_certificate and tls_client_key must be specified " +
+				"for the client certificate authentication. Please check the ENV variables and TF files.")
+		}
+
+		certPem, err := readPem(clientCertificate)
+		if err != nil {
+			ColorizedDebug(ctx, "Failed to read client certificate '"+clientCertificate+"', error: "+err.Error())
+			return nil, diag.Errorf("Failed to read client certificate '%s', %v", clientCertificate, err)
+		}
+
+		keyPem, err := readPem(clientKey)
+		if err != nil {
+			ColorizedDebug(ctx, "Failed to read client key file, error: "+err.Error())
+			return nil, diag.Errorf("Failed to read client key file, %v", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			return nil, diag.Errorf("Failed to load client certificate, %v", err)
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
 	}
+
+	routerUrl, err := parseRouterUrl(creds.HostURL)
 	if err != nil {
 		return nil, diag.Diagnostics{
 			{
 				Severity: diag.Error,
 				Summary:  err.Error(),
-				Detail:   "Error while parsing the router URL: '" + d.Get("hosturl").(string) + "'",
+				Detail:   "Error while parsing the router URL: '" + creds.HostURL + "'",
 			},
 		}
 	}
 	routerUrl.Path = strings.TrimSuffix(routerUrl.Path, "/")
 
-	var useTLS = true
-	var transport = TransportREST
+	scheme := routerUrl.Scheme
+	transport, useTLS := routerTransport(routerUrl)
+
+	// Other addresses of the router, they are used if the connection to the previous one fails.
+	routerUrls := []*url.URL{routerUrl}
+	for _, v := range d.Get("failover_hosts").([]interface{}) {
+		host := v.(string)
+		if !strings.Contains(host, "://") {
+			host = scheme + "://" + host
+		}
+
+		u, err := parseRouterUrl(host)
+		if err != nil {
+			return nil, diag.Errorf("Error while parsing the failover host URL: '%s', %v", v, err)
+		}
+		u.Path = strings.TrimSuffix(u.Path, "/")
+
+		if u.Scheme != scheme {
+			return nil, diag.Errorf("The failover host '%s' must use the same transport as hosturl: '%s'", v, scheme)
+		}
+		routerTransport(u)
+		routerUrls = append(routerUrls, u)
+	}
+
+	retryBackoff, err := ParseDuration(d.Get("retry_backoff").(string), time.Second)
+	if err != nil {
+		return nil, diag.Errorf("Failed to parse retry_backoff, %v", err)
+	}
+
+	var rebootWaitTimeout time.Duration
+	if v := d.Get("reboot_wait_timeout").(string); v != "" {
+		if rebootWaitTimeout, err = ParseDuration(v, time.Second); err != nil {
+			return nil, diag.Errorf("Failed to parse reboot_wait_timeout, %v", err)
+		}
+	}
+
+	timeouts, err := parseDefaultTimeouts(d)
+	if err != nil {
+		return nil, diag.Errorf("Failed to parse the default timeouts, %v", err)
+	}
+
+	extra := &ExtraParams{
+		SuppressSysODelWarn: d.Get("suppress_syso_del_warn").(bool),
+		MaxRetries:          d.Get("max_retries").(int),
+		RetryBackoff:        retryBackoff,
+		ReadOnly:            d.Get("read_only").(bool),
+		ManagedComment:      d.Get("managed_comment").(string),
+		PageSize:            d.Get("rest_page_size").(int),
+		RebootWaitTimeout:   rebootWaitTimeout,
+		Timeouts:            timeouts,
+		ConflictCheck:       d.Get("conflict_check").(string),
+	}
+
+	if path := d.Get("metrics_path").(string); path != "" {
+		extra.Metrics = NewMetrics(path)
+	}
+
+	if path := d.Get("audit_log_path").(string); path != "" {
+		if extra.AuditLog, err = NewAuditLog(path); err != nil {
+			return nil, diag.Errorf("Failed to open the audit log '%s', %v", path, err)
+		}
+	}
+
+	var socksUrl *url.URL
+	if socksProxy := d.Get("socks_proxy").(string); socksProxy != "" {
+		if socksUrl, err = url.Parse(socksProxy); err != nil {
+			return nil, diag.Errorf("Error while parsing the SOCKS proxy URL: '%s'", socksProxy)
+		}
+	}
+
+	httpProxy := http.ProxyFromEnvironment
+	if proxyUrl := d.Get("proxy_url").(string); proxyUrl != "" {
+		u, err := url.Parse(proxyUrl)
+		if err != nil || u.Host == "" {
+			return nil, diag.Errorf("Error while parsing the proxy URL: '%s'", proxyUrl)
+		}
+		httpProxy = http.ProxyURL(u)
+	}
+
+	version := d.Get("routeros_version").(string)
+	if version != "" {
+		ColorizedMessage(ctx, INFO, "RouterOS from env: "+version)
+	}
+	skipVersionDetection := d.Get("skip_version_detection").(bool)
+
+	// The connection is established on the first request, so the configuration can be validated and
+	// planned without the router being reachable.
+	// The transport can differ from the provider one for the resources with '___transport___'.
+	connectTransport := func(transport TransportType, useTLS bool, routerUrl *url.URL) (Client, diag.Diagnostics) {
+		var diags diag.Diagnostics
+		var err error
+
+		// Connections to the router can be tunneled through the SSH jump host and/or SOCKS5 proxy.
+		var dialer proxy.Dialer = proxy.Direct
+		var bastion *ssh.Client
+
+		if bastionHost := d.Get("bastion_host").(string); bastionHost != "" {
+			bastion, err = dialBastion(bastionHost, d.Get("bastion_user").(string), d.Get("bastion_private_key").(string),
+				d.Get("insecure").(bool))
+			if err != nil {
+				ColorizedDebug(ctx, "Failed to connect to the bastion host '"+bastionHost+"', error: "+err.Error())
+				return nil, diag.Errorf("Failed to connect to the bastion host '%s', %v", bastionHost, err)
+			}
+			dialer = bastion
+		}
+
+		if socksUrl != nil {
+			if dialer, err = proxy.FromURL(socksUrl, dialer); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		}
+
+		if transport == TransportAPI {
+			api := &ApiClient{
+				ctx:       ctx,
+				HostURL:   routerUrl.Host,
+				Username:  creds.Username,
+				Password:  creds.Password,
+				Transport: TransportAPI,
+				extra:     extra,
+			}
+
+			if err = connectApi(api, dialer, useTLS, &tlsConf); err != nil {
+				return nil, diag.FromErr(err)
+			}
+
+			if api.version, diags = detectRouterOSVersion(ctx, api, version, skipVersionDetection); diags != nil {
+				_ = api.Close()
+				return nil, diags
+			}
+
+			return api, nil
+		}
+
+		if transport == TransportSSH {
+			sshc := &SshClient{
+				ctx:       ctx,
+				HostURL:   routerUrl.Host,
+				Username:  creds.Username,
+				Password:  creds.Password,
+				Transport: TransportSSH,
+				extra:     extra,
+			}
+
+			hostKeyCallback, err := sshHostKeyCallback(d.Get("insecure").(bool))
+			if err != nil {
+				ColorizedDebug(ctx, "Failed to read known_hosts file, error: "+err.Error())
+				return nil, diag.Errorf("Failed to read known_hosts file, %v", err)
+			}
+
+			sshc.Client, err = dialSsh(dialer, sshc.HostURL, &ssh.ClientConfig{
+				User:            sshc.Username,
+				Auth:            []ssh.AuthMethod{ssh.Password(sshc.Password)},
+				HostKeyCallback: hostKeyCallback,
+				Timeout:         time.Duration(d.Get("rest_timeout").(int)) * time.Second,
+			})
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
+			if sshc.version, diags = detectRouterOSVersion(ctx, sshc, version, skipVersionDetection); diags != nil {
+				return nil, diags
+			}
+
+			return sshc, nil
+		}
+
+		rest := &RestClient{
+			ctx:       ctx,
+			HostURL:   routerUrl.String(),
+			Username:  creds.Username,
+			Password:  creds.Password,
+			Transport: TransportREST,
+			extra:     extra,
+			userAgent: restUserAgent,
+			headers:   map[string]string{},
+		}
+
+		if suffix := d.Get("user_agent_suffix").(string); suffix != "" {
+			rest.userAgent += " " + suffix
+		}
+
+		for k, v := range d.Get("extra_headers").(map[string]interface{}) {
+			rest.headers[k] = v.(string)
+		}
+
+		// Keep the connections open between requests: Terraform runs up to 10 operations in parallel by default.
+		// The TLS session cache allows new connections to resume sessions without a full handshake.
+		restTLSConf := tlsConf.Clone()
+		restTLSConf.ClientSessionCache = tls.NewLRUClientSessionCache(0)
+
+		// The transport adds 'Accept-Encoding: gzip' and decompresses the responses transparently.
+		maxIdleConns := d.Get("rest_max_idle_conns").(int)
+		httpTransport := &http.Transport{
+			TLSClientConfig:     restTLSConf,
+			Proxy:               httpProxy,
+			MaxIdleConns:        maxIdleConns,
+			MaxIdleConnsPerHost: maxIdleConns,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+			DisableCompression:  !d.Get("rest_compression").(bool),
+		}
+
+		if bastion != nil {
+			httpTransport.DialContext = func(_ context.Context, network, addr string) (net.Conn, error) {
+				return bastion.Dial(network, addr)
+			}
+		}
+
+		rest.Client = &http.Client{
+			// ... By default, CreateContext has a 20 minute timeout ...
+			// but MT REST API timeout is in 60 seconds for any operation.
+			// Make the timeout smaller so that the lifetime of the context is less than the lifetime of the session.
+			Timeout:   time.Duration(d.Get("rest_timeout").(int)) * time.Second,
+			Transport: httpTransport,
+		}
+
+		// RouterOS 6 has no REST API, and it is not available if the www-ssl service is disabled.
+		// In this case, the binary API-SSL on the same host is used.
+		if _, err = ReadItems(nil, "/system/resource", rest); routerUrl.Scheme == "https" &&
+			(errors.Is(err, errRestNotFound) || errors.Is(err, syscall.ECONNREFUSED)) {
+
+			api := &ApiClient{
+				ctx:       ctx,
+				HostURL:   net.JoinHostPort(routerUrl.Hostname(), "8729"),
+				Username:  creds.Username,
+				Password:  creds.Password,
+				Transport: TransportAPI,
+				extra:     extra,
+			}
+
+			if e := connectApi(api, dialer, true, &tlsConf); e != nil {
+				ColorizedDebug(ctx, "Failed to connect to API-SSL '"+api.HostURL+"', error: "+e.Error())
+				return nil, diag.FromErr(errors.Join(err, fmt.Errorf("API-SSL fallback '%v': %w", api.HostURL, e)))
+			}
+
+			diags = diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "REST API is not available, the binary API-SSL is used.",
+				Detail: fmt.Sprintf("The request to '%v' failed: %v. The provider is connected to '%v'. "+
+					"Use hosturl = \"apis://%[3]v\" to avoid this check.", rest.HostURL, err, api.HostURL),
+			}}
+
+			var versionDiags diag.Diagnostics
+			if api.version, versionDiags = detectRouterOSVersion(ctx, api, version, skipVersionDetection); versionDiags != nil {
+				_ = api.Close()
+				return nil, versionDiags
+			}
+
+			return api, diags
+		}
+
+		if rest.version, diags = detectRouterOSVersion(ctx, rest, version, skipVersionDetection); diags != nil {
+			return nil, diags
+		}
+
+		return rest, nil
+	}
+
+	return &lazyClient{
+		ctx:       ctx,
+		transport: transport,
+		extra:     extra,
+		version:   version,
+		hosts:     routerUrls,
+		connect: func(u *url.URL) (Client, diag.Diagnostics) {
+			return connectTransport(transport, useTLS, u)
+		},
+		connectTransport: connectTransport,
+		credentials: func() error {
+			c, diags := getCredentials(context.WithoutCancel(ctx), d)
+			if diags.HasError() {
+				return diagsError(ctx, diags)
+			}
+
+			// The router address is not changed, only the user credentials.
+			creds.Username, creds.Password = c.Username, c.Password
+			return nil
+		},
+	}, nil
+}
+
+// routerTransport Returns the transport of the URL scheme.
+// The scheme of the API and SSH transports is removed and the default port is added.
+func routerTransport(u *url.URL) (transport TransportType, useTLS bool) {
+	useTLS = true
+	transport = TransportREST
 
-	// Parse URL.
-	switch routerUrl.Scheme {
+	switch u.Scheme {
 	case "http":
 	case "https":
 	case "apis":
-		routerUrl.Scheme = ""
-		if routerUrl.Port() == "" {
-			routerUrl.Host += ":8729"
-		}
+		u.Scheme = ""
+		setDefaultPort(u, "8729")
 		transport = TransportAPI
 	case "api":
-		routerUrl.Scheme = ""
-		if routerUrl.Port() == "" {
-			routerUrl.Host += ":8728"
-		}
+		u.Scheme = ""
+		setDefaultPort(u, "8728")
 		useTLS = false
 		transport = TransportAPI
+	case "ssh":
+		u.Scheme = ""
+		setDefaultPort(u, "22")
+		transport = TransportSSH
 	default:
-		panic("[NewClient] wrong transport type: " + routerUrl.Scheme)
+		panic("[NewClient] wrong transport type: " + u.Scheme)
 	}
 
-	RouterOSVersion = d.Get("routeros_version").(string)
-	if RouterOSVersion != "" {
-		ColorizedMessage(ctx, INFO, "RouterOS from env: "+RouterOSVersion)
+	return
+}
+
+// parseRouterUrl Parses the router URL, the default scheme is https.
+// IPv6 literals are accepted with or without brackets, and the zone ID can be escaped or not:
+// apis://[fe80::1%25ether1]:8729, apis://[fe80::1%ether1], fe80::1%ether1.
+func parseRouterUrl(hostUrl string) (*url.URL, error) {
+	scheme, host := "https", hostUrl
+	if s, h, ok := strings.Cut(hostUrl, "://"); ok {
+		scheme, host = s, h
 	}
 
-	if transport == TransportAPI {
-		api := &ApiClient{
-			ctx:       ctx,
-			HostURL:   routerUrl.Host,
-			Username:  d.Get("username").(string),
-			Password:  d.Get("password").(string),
-			Transport: TransportAPI,
-			extra: &ExtraParams{
-				SuppressSysODelWarn: d.Get("suppress_syso_del_warn").(bool),
-			},
+	var path string
+	if i := strings.Index(host, "/"); i != -1 {
+		host, path = host[:i], host[i:]
+	}
+
+	// A bare IPv6 literal is ambiguous: the last group is parsed as a port.
+	if addr, err := netip.ParseAddr(host); err == nil && addr.Is6() {
+		host = "[" + host + "]"
+	}
+
+	// The '%' of the zone ID must be escaped in URLs (RFC 6874).
+	if strings.HasPrefix(host, "[") {
+		if i := strings.Index(host, "%"); i != -1 && !strings.HasPrefix(host[i:], "%25") {
+			host = host[:i] + "%25" + host[i+1:]
 		}
+	}
 
-		if useTLS {
-			api.Client, err = routeros.DialTLS(api.HostURL, api.Username, api.Password, &tlsConf)
+	u, err := url.Parse(scheme + "://" + host + path)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("the router host is empty")
+	}
+
+	return u, nil
+}
+
+// setDefaultPort Adds the port to the URL host if it is not specified.
+func setDefaultPort(u *url.URL, port string) {
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+}
+
+// connectApi Connects to the API and switches the client to asynchronous mode.
+// The connection parameters are kept to reconnect if the session is lost.
+func connectApi(api *ApiClient, dialer proxy.Dialer, useTLS bool, tlsConf *tls.Config) error {
+	api.dial = func() (*routeros.Client, error) {
+		var c *routeros.Client
+		var err error
+
+		if dialer != proxy.Direct {
+			c, err = dialApi(api, dialer, useTLS, tlsConf)
+		} else if useTLS {
+			c, err = routeros.DialTLS(api.HostURL, api.Username, api.Password, tlsConf)
 		} else {
-			api.Client, err = routeros.Dial(api.HostURL, api.Username, api.Password)
+			c, err = routeros.Dial(api.HostURL, api.Username, api.Password)
 		}
 		if err != nil {
-			return nil, diag.FromErr(err)
+			return nil, err
 		}
 
 		// The synchronous client has an infinite wait issue
 		// when an error occurs while creating multiple resources.
-		api.Async()
-
-		if RouterOSVersion == "" {
-			ros, diags := GetRouterOSVersion(api)
-			if diags != nil {
-				return nil, diags
-			}
+		c.Async()
 
-			RouterOSVersion = ros
-			ColorizedMessage(ctx, INFO, "RouterOS: "+RouterOSVersion)
-		}
-
-		return api, nil
+		return c, nil
 	}
 
-	rest := &RestClient{
-		ctx:       ctx,
-		HostURL:   routerUrl.String(),
-		Username:  d.Get("username").(string),
-		Password:  d.Get("password").(string),
-		Transport: TransportREST,
-		extra: &ExtraParams{
-			SuppressSysODelWarn: d.Get("suppress_syso_del_warn").(bool),
-		},
+	var err error
+	if api.Client, err = api.dial(); err != nil {
+		return err
 	}
 
-	rest.Client = &http.Client{
-		// ... By default, CreateContext has a 20 minute timeout ...
-		// but MT REST API timeout is in 60 seconds for any operation.
-		// Make the timeout smaller so that the lifetime of the context is less than the lifetime of the session.
-		Timeout: time.Duration(d.Get("rest_timeout").(int)) * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tlsConf,
-		},
+	api.done = make(chan struct{})
+	go api.keepalive(apiKeepaliveInterval)
+
+	return nil
+}
+
+// dialApi Connects to the API through the SOCKS5 proxy or SSH tunnel and logs in.
+func dialApi(api *ApiClient, dialer proxy.Dialer, useTLS bool, tlsConf *tls.Config) (*routeros.Client, error) {
+	conn, err := dialer.Dial("tcp", api.HostURL)
+	if err != nil {
+		return nil, err
 	}
 
-	if RouterOSVersion == "" {
-		ros, diags := GetRouterOSVersion(rest)
-		if diags != nil {
-			return nil, diags
+	if useTLS {
+		conf := tlsConf.Clone()
+		if conf.ServerName == "" {
+			conf.ServerName, _, _ = net.SplitHostPort(api.HostURL)
 		}
+		conn = tls.Client(conn, conf)
+	}
 
-		RouterOSVersion = ros
-		ColorizedMessage(ctx, INFO, "RouterOS: "+RouterOSVersion)
+	c, err := routeros.NewClient(conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
 	}
 
-	return rest, nil
+	if err = c.Login(api.Username, api.Password); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+
+	return c, nil
 }
 
 type URL struct {
@@ -231,6 +729,35 @@ func EscapeChars(data []byte) []byte {
 	return res
 }
 
+// detectRouterOSVersion Returns the version from the provider configuration or reads it from the router.
+// Each client keeps its own version, so provider aliases can manage routers with different versions.
+func detectRouterOSVersion(ctx context.Context, c Client, version string, skip bool) (string, diag.Diagnostics) {
+	if version != "" {
+		return version, nil
+	}
+
+	if skip {
+		ColorizedMessage(ctx, WARN, "RouterOS version detection is disabled, the attribute drift compensation is not used")
+		return "", nil
+	}
+
+	ros, diags := GetRouterOSVersion(c)
+	if diags != nil {
+		return "", diags
+	}
+
+	ColorizedMessage(ctx, INFO, "RouterOS: "+ros)
+	return ros, nil
+}
+
+// routerOSVersion Returns the RouterOS version of the provider client.
+func routerOSVersion(m interface{}) string {
+	if c, ok := m.(Client); ok {
+		return c.GetRouterOSVersion()
+	}
+	return ""
+}
+
 // Obtain a version of RouterOS to automatically customize resource schemas.
 func GetRouterOSVersion(m interface{}) (string, diag.Diagnostics) {
 	res, err := ReadItems(nil, "/system/resource", m.(Client))
@@ -2,9 +2,15 @@ package routeros
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
 	"reflect"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/go-routeros/routeros/v3"
 )
@@ -16,25 +22,44 @@ type ApiClient struct {
 	Password  string
 	Transport TransportType
 	extra     *ExtraParams
+	version   string
+	dial      func() (*routeros.Client, error)
+	mu        sync.Mutex
+	// done Stops the keepalive requests when the client is closed.
+	done      chan struct{}
+	closeOnce sync.Once
 	*routeros.Client
 }
 
+// The interval of requests that keep the API session alive.
+const apiKeepaliveInterval = time.Minute
+
 var (
 	apiMethodName = map[crudMethod]string{
-		crudCreate:      "/add",
-		crudRead:        "/print",
-		crudUpdate:      "/set",
-		crudDelete:      "/remove",
-		crudPost:        "/set",
-		crudImport:      "/import",
-		crudSign:        "/sign",
-		crudSignViaScep: "/add-scep",
-		crudRemove:      "/remove",
-		crudRevoke:      "/issued-revoke",
-		crudMove:        "/move",
-		crudStart:       "/start",
-		crudStop:        "/stop",
-		crudGenerateKey: "/generate-key",
+		crudCreate:           "/add",
+		crudRead:             "/print",
+		crudUpdate:           "/set",
+		crudDelete:           "/remove",
+		crudPost:             "/set",
+		crudImport:           "/import",
+		crudSign:             "/sign",
+		crudSignViaScep:      "/add-scep",
+		crudRemove:           "/remove",
+		crudRevoke:           "/issued-revoke",
+		crudMove:             "/move",
+		crudStart:            "/start",
+		crudStop:             "/stop",
+		crudGenerateKey:      "/generate-key",
+		crudActivate:         "/activate",
+		crudEnable:           "/enable",
+		crudDisable:          "/disable",
+		crudApplyChanges:     "/apply-changes",
+		crudDeviceModeUpdate: "/update",
+		crudCheckForUpdates:  "/check-for-updates",
+		crudMonitor:          "/monitor",
+		crudExecute:          "",
+		crudPrint:            "/print",
+		crudRunScript:        "",
 	}
 )
 
@@ -46,7 +71,122 @@ func (c *ApiClient) GetTransport() TransportType {
 	return c.Transport
 }
 
+func (c *ApiClient) GetRouterOSVersion() string {
+	return c.version
+}
+
+func (c *ApiClient) getClient() *routeros.Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.Client
+}
+
+// reconnect Replaces the lost connection with a new one and logs in again.
+// If another request has already reconnected, the current connection is returned.
+func (c *ApiClient) reconnect(lost *routeros.Client) (*routeros.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.isClosed() {
+		return nil, net.ErrClosed
+	}
+
+	if c.Client != lost {
+		return c.Client, nil
+	}
+
+	client, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+
+	_ = lost.Close()
+	c.Client = client
+
+	return client, nil
+}
+
+// keepalive Periodically sends a lightweight request so that the session is not closed
+// by idle timeouts and a lost session is restored before the next request.
+func (c *ApiClient) keepalive(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+		}
+
+		client := c.getClient()
+		if _, err := client.Run("/system/identity/print"); isConnectionLost(err) {
+			ColorizedDebug(c.ctx, "API session lost, reconnecting: "+err.Error())
+			_, _ = c.reconnect(client)
+		}
+	}
+}
+
+// Close Stops the keepalive requests and closes the API session.
+func (c *ApiClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closeOnce.Do(func() {
+		if c.done != nil {
+			close(c.done)
+		}
+	})
+
+	if c.Client == nil {
+		return nil
+	}
+	return c.Client.Close()
+}
+
+func (c *ApiClient) isClosed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// isIdempotent Returns true if the request can be repeated after the connection is lost or the request failed:
+// the router may have executed the request before the failure. Used by the reconnect, the retry and the failover.
+func isIdempotent(method crudMethod) bool {
+	switch method {
+	case crudRead, crudPrint, crudUpdate, crudPost, crudEnable, crudDisable, crudMonitor:
+		return true
+	}
+	return false
+}
+
+func isConnectionLost(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) || strings.Contains(err.Error(), "loop has ended")
+}
+
 func (c *ApiClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	// The provider configuration context is only used for logging, it can be cancelled after configuration.
+	return c.SendRequestContext(context.WithoutCancel(c.ctx), method, url, item, result)
+}
+
+// SendRequestContext Sends the request within the context of the Terraform operation:
+// the operation timeout and tracing.
+func (c *ApiClient) SendRequestContext(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return doRequest(ctx, c.extra, c.Transport, method, url, item, func(ctx context.Context) error {
+		return c.sendRequest(ctx, method, url, item, result)
+	})
+}
+
+func (c *ApiClient) sendRequest(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
 
 	// https://help.mikrotik.com/docs/display/ROS/API
 	// /interface/vlan/print + '?.id=*39' + '?type=vlan'
@@ -60,7 +200,20 @@ func (c *ApiClient) SendRequest(method crudMethod, url *URL, item MikrotikItem,
 	}
 	ColorizedDebug(c.ctx, "request body:  "+strings.Join(cmd, " "))
 
-	resp, err := c.RunArgs(cmd)
+	client := c.getClient()
+	resp, err := client.RunArgsContext(ctx, cmd)
+	if isConnectionLost(err) && c.dial != nil {
+		ColorizedMessage(c.ctx, WARN, "API session lost, reconnecting: "+err.Error())
+		lost := err
+		if client, err = c.reconnect(client); err != nil {
+			return err
+		}
+		if !isIdempotent(method) {
+			return fmt.Errorf("the API session was lost, the request '%v' is not repeated because it may have "+
+				"been executed: %w", cmd[0], lost)
+		}
+		resp, err = client.RunArgsContext(ctx, cmd)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,96 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-routeros/routeros/v3"
+	"github.com/go-routeros/routeros/v3/proto"
+)
+
+// testApiServer Replies '!done' to the commands, the connection is closed after the command if 'lose' is set.
+func testApiServer(conn net.Conn, lose bool, commands *atomic.Int32) {
+	defer func() { _ = conn.Close() }()
+
+	r, w := proto.NewReader(conn), proto.NewWriter(conn)
+	for {
+		sentence, err := r.ReadSentence()
+		if err != nil || lose {
+			return
+		}
+		commands.Add(1)
+
+		w.BeginSentence()
+		w.WriteWord("!done")
+		w.WriteWord(".tag=" + sentence.Tag)
+		_ = w.EndSentence()
+	}
+}
+
+func testApiClient(t *testing.T, lose bool, commands *atomic.Int32) *routeros.Client {
+	conn, srv := net.Pipe()
+	go testApiServer(srv, lose, commands)
+
+	client, err := routeros.NewClient(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Async()
+	return client
+}
+
+func TestApiClient_Reconnect(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       crudMethod
+		wantErr      bool
+		wantCommands int32
+	}{
+		{"Read is repeated", crudRead, false, 1},
+		{"Create is not repeated", crudCreate, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var commands atomic.Int32
+			c := &ApiClient{ctx: context.Background(), Transport: TransportAPI, done: make(chan struct{})}
+			c.Client = testApiClient(t, true, &commands)
+			c.dial = func() (*routeros.Client, error) {
+				return testApiClient(t, false, &commands), nil
+			}
+			defer func() { _ = c.Close() }()
+
+			err := c.SendRequest(tt.method, &URL{Path: "/ip/pool"}, MikrotikItem{"name": "pool1"}, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SendRequest() error = %v, want an error %v", err, tt.wantErr)
+			}
+			if n := commands.Load(); n != tt.wantCommands {
+				t.Errorf("commands after the reconnect = %v, want %v", n, tt.wantCommands)
+			}
+		})
+	}
+}
+
+func TestApiClient_Close(t *testing.T) {
+	var commands atomic.Int32
+	c := &ApiClient{ctx: context.Background(), Transport: TransportAPI, done: make(chan struct{})}
+	c.Client = testApiClient(t, false, &commands)
+	c.dial = func() (*routeros.Client, error) {
+		t.Error("the closed client is connected again")
+		return nil, errors.New("closed")
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !c.isClosed() {
+		t.Errorf("the keepalive requests are not stopped")
+	}
+	if _, err := c.reconnect(c.getClient()); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("reconnect() error = %v, want %v", err, net.ErrClosed)
+	}
+	// The second call does not panic.
+	_ = c.Close()
+}
@@ -0,0 +1,109 @@
+package routeros
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const credentialsCommandTimeout = time.Minute
+
+type routerCredentials struct {
+	HostURL  string `json:"hosturl"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// merge Replaces the values with non-empty values from the external source.
+func (c *routerCredentials) merge(src *routerCredentials) {
+	if src.HostURL != "" {
+		c.HostURL = src.HostURL
+	}
+	if src.Username != "" {
+		c.Username = src.Username
+	}
+	if src.Password != "" {
+		c.Password = src.Password
+	}
+}
+
+// getCredentials Returns the router URL and the user credentials from the provider configuration
+// and external sources.
+func getCredentials(ctx context.Context, d *schema.ResourceData) (*routerCredentials, diag.Diagnostics) {
+	creds := &routerCredentials{
+		HostURL:  d.Get("hosturl").(string),
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+
+	if address := d.Get("vault_address").(string); address != "" {
+		c, err := getVaultCredentials(ctx, &vaultConfig{
+			Address:    address,
+			Path:       d.Get("vault_path").(string),
+			AuthMethod: d.Get("vault_auth_method").(string),
+			Token:      d.Get("vault_token").(string),
+			RoleId:     d.Get("vault_role_id").(string),
+			SecretId:   d.Get("vault_secret_id").(string),
+		})
+		if err != nil {
+			return nil, diag.Errorf("Failed to get credentials from Vault, %v", err)
+		}
+		creds.merge(c)
+	}
+
+	if command := d.Get("credentials_command").(string); command != "" {
+		c, err := runCredentialsCommand(ctx, command)
+		if err != nil {
+			return nil, diag.Errorf("Failed to get credentials from the command, %v", err)
+		}
+		creds.merge(c)
+	}
+
+	if creds.HostURL == "" {
+		return nil, diag.Errorf("The router URL is not defined. Please check the ENV variables and TF files.")
+	}
+	if creds.Username == "" {
+		return nil, diag.Errorf("The username is not defined. Please check the ENV variables and TF files.")
+	}
+
+	return creds, nil
+}
+
+// runCredentialsCommand Executes the command in the shell and parses its JSON output:
+// {"hosturl": "...", "username": "...", "password": "..."}
+func runCredentialsCommand(ctx context.Context, command string) (*routerCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialsCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// The output contains secrets and is not logged.
+	ColorizedDebug(ctx, "Running credentials command")
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var creds routerCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse the command output as JSON: %v", err)
+	}
+
+	return &creds, nil
+}
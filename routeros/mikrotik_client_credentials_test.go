@@ -0,0 +1,57 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestRunCredentialsCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test uses a POSIX shell")
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		want    *routerCredentials
+		wantErr bool
+	}{
+		{
+			"JSON output",
+			`echo '{"hosturl": "https://router.local", "username": "admin", "password": "secret"}'`,
+			&routerCredentials{HostURL: "https://router.local", Username: "admin", Password: "secret"},
+			false,
+		},
+		{
+			"Partial output",
+			`printf '{"password": "secret"}'`,
+			&routerCredentials{Password: "secret"},
+			false,
+		},
+		{
+			"Wrong output",
+			`echo secret`,
+			nil,
+			true,
+		},
+		{
+			"Command failed",
+			`exit 1`,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runCredentialsCommand(context.Background(), tt.command)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runCredentialsCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("runCredentialsCommand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// lazyClient Connects to the router on the first request.
+// 'terraform validate' and plans without refresh do not need the router to be reachable.
+// If the router has several addresses, the client fails over to the next one when the connection is lost.
+type lazyClient struct {
+	ctx       context.Context
+	transport TransportType
+	extra     *ExtraParams
+	version   string
+	hosts     []*url.URL
+	connect   func(*url.URL) (Client, diag.Diagnostics)
+	// connectTransport Connects to the router by another transport than the provider one.
+	connectTransport func(transport TransportType, useTLS bool, u *url.URL) (Client, diag.Diagnostics)
+	// overrides The clients of the other transports, see withTransport.
+	overrides map[TransportType]*lazyClient
+	// credentials Reloads the user credentials from the configuration and external sources.
+	credentials func() error
+	mu          sync.Mutex
+	current     int
+	client      Client
+	err         error
+	// rebootAt The time of the last request that rebooted the router.
+	rebootAt time.Time
+}
+
+// getClient Returns the connected client. If the connection has failed, the next request connects again.
+func (c *lazyClient) getClient() (Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		c.dial(c.current)
+	}
+
+	return c.client, c.err
+}
+
+// withTransport Returns the client of the transport for the resources that override the provider one.
+// The resources of the same transport share the client, it connects to the router on the first request
+// to the same addresses with the default port of the binary API.
+func (c *lazyClient) withTransport(transport TransportType) Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if transport == c.transport || (c.client != nil && c.client.GetTransport() == transport) ||
+		c.connectTransport == nil {
+		return c
+	}
+
+	if client, ok := c.overrides[transport]; ok {
+		return client
+	}
+
+	// API-SSL is used unless the router is configured without TLS.
+	useTLS := true
+	hosts := make([]*url.URL, 0, len(c.hosts))
+	for _, u := range c.hosts {
+		port := "8729"
+		if u.Scheme == "http" {
+			port, useTLS = "8728", false
+		}
+		hosts = append(hosts, &url.URL{Host: net.JoinHostPort(u.Hostname(), port)})
+	}
+
+	client := &lazyClient{
+		ctx:       c.ctx,
+		transport: transport,
+		extra:     c.extra,
+		version:   c.version,
+		hosts:     hosts,
+		connect: func(u *url.URL) (Client, diag.Diagnostics) {
+			return c.connectTransport(transport, useTLS, u)
+		},
+		credentials: c.credentials,
+	}
+
+	if c.overrides == nil {
+		c.overrides = map[TransportType]*lazyClient{}
+	}
+	c.overrides[transport] = client
+
+	return client
+}
+
+// failover Connects to the next router address if the failed client is still the current one.
+func (c *lazyClient) failover(failed Client) (Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == failed {
+		ColorizedMessage(c.ctx, WARN, "Connection to '"+c.hosts[c.current].Host+"' is lost, failing over")
+		c.redial(c.current + 1)
+	}
+
+	return c.client, c.err
+}
+
+// relogin Reloads the credentials and connects to the router again if the failed client is still the current one.
+func (c *lazyClient) relogin(failed Client) (Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == failed {
+		ColorizedMessage(c.ctx, WARN, "Authentication failed, logging in again")
+
+		// The session of the rejected credentials is closed first.
+		closeClient(c.client)
+		c.client = nil
+
+		if c.credentials != nil {
+			if err := c.credentials(); err != nil {
+				return nil, err
+			}
+		}
+		c.dial(c.current)
+	}
+
+	return c.client, c.err
+}
+
+// redial Closes the failed client and connects to the router again.
+func (c *lazyClient) redial(start int) {
+	if c.client != nil {
+		closeClient(c.client)
+	}
+	c.dial(start)
+}
+
+// dial Tries the router addresses in order, starting from the given one.
+func (c *lazyClient) dial(start int) {
+	c.client, c.err = nil, nil
+
+	var errs []error
+	for i := range c.hosts {
+		n := (start + i) % len(c.hosts)
+
+		client, diags := c.connect(c.hosts[n])
+		if err := diagsError(c.ctx, diags); err != nil {
+			if len(c.hosts) > 1 {
+				ColorizedMessage(c.ctx, WARN, "Failed to connect to '"+c.hosts[n].Host+"': "+err.Error())
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		c.client, c.current = client, n
+		return
+	}
+
+	c.err = errors.Join(errs...)
+}
+
+// closeClient Closes the connections of the client that is no longer used:
+// the API session and its keepalive requests, the SSH session or the idle HTTP connections.
+func closeClient(c Client) {
+	switch c := c.(type) {
+	case io.Closer:
+		_ = c.Close()
+	case interface{ CloseIdleConnections() }:
+		c.CloseIdleConnections()
+	}
+}
+
+// diagsError Logs the warnings and returns the errors of the diagnostics.
+func diagsError(ctx context.Context, diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity == diag.Warning {
+			ColorizedMessage(ctx, WARN, d.Summary+" "+d.Detail)
+			continue
+		}
+
+		if d.Detail != "" {
+			errs = append(errs, errors.New(d.Summary+": "+d.Detail))
+		} else {
+			errs = append(errs, errors.New(d.Summary))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// isAuthError Returns true if the router rejected the user credentials.
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	return errors.Is(err, errRestUnauthorized) || strings.Contains(msg, "invalid user name or password") ||
+		strings.Contains(msg, "unable to authenticate")
+}
+
+// isConnectionError Returns true if the router is unreachable or the connection is lost.
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return isConnectionLost(err) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EHOSTUNREACH) || errors.Is(err, syscall.ENETUNREACH)
+}
+
+func (c *lazyClient) GetExtraParams() *ExtraParams {
+	return c.extra
+}
+
+// GetTransport Returns the transport of the connected client, it can differ from the configured one
+// if REST API is not available.
+func (c *lazyClient) GetTransport() TransportType {
+	if client, _ := c.getClient(); client != nil {
+		return client.GetTransport()
+	}
+	return c.transport
+}
+
+// GetRouterOSVersion Returns the configured version without connecting to the router.
+func (c *lazyClient) GetRouterOSVersion() string {
+	if c.version != "" {
+		return c.version
+	}
+
+	if client, _ := c.getClient(); client != nil {
+		return client.GetRouterOSVersion()
+	}
+	return ""
+}
+
+// knownRouterOSVersion Returns the configured version or the version of the connected client, the router
+// is not connected.
+func (c *lazyClient) knownRouterOSVersion() string {
+	if c.version != "" {
+		return c.version
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return c.client.GetRouterOSVersion()
+	}
+	return ""
+}
+
+func (c *lazyClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return c.SendRequestContext(context.WithoutCancel(c.ctx), method, url, item, result)
+}
+
+func (c *lazyClient) SendRequestContext(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+
+	err = sendClientRequest(ctx, client, method, url, item, result)
+
+	if c.extra != nil && c.extra.RebootWaitTimeout > 0 {
+		// The router can close the connection before replying to the reboot request.
+		if isRebootRequest(method, url) && (err == nil || isConnectionError(err)) {
+			c.rebootStarted()
+			return nil
+		}
+
+		for isConnectionError(err) && c.rebootPending() {
+			failed := err
+			if client, err = c.waitReboot(ctx, client); err != nil {
+				return err
+			}
+			if !canResend(method, failed) {
+				return notResentError(method, failed)
+			}
+			err = sendClientRequest(ctx, client, method, url, item, result)
+		}
+	}
+
+	switch {
+	case isAuthError(err):
+		// The login session has expired or the password has been changed during the apply.
+		if client, err = c.relogin(client); err != nil {
+			return err
+		}
+	case len(c.hosts) > 1 && isConnectionError(err):
+		failed := err
+		if client, err = c.failover(client); err != nil {
+			return err
+		}
+		// The next requests use the new router, this one may have been executed by the failed router.
+		if !canResend(method, failed) {
+			return notResentError(method, failed)
+		}
+	default:
+		return err
+	}
+
+	return sendClientRequest(ctx, client, method, url, item, result)
+}
+
+// canResend Returns true if the request that failed with the connection error can be sent again: the request is
+// idempotent or it has not reached the router.
+func canResend(method crudMethod, err error) bool {
+	return isIdempotent(method) || isNotSentError(err)
+}
+
+func notResentError(method crudMethod, err error) error {
+	return fmt.Errorf("the connection was lost, the '%v' request is not repeated because it may have been "+
+		"executed: %w", method, err)
+}
+
+func sendClientRequest(ctx context.Context, client Client, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	if sender, ok := client.(requestContextSender); ok {
+		return sender.SendRequestContext(ctx, method, url, item, result)
+	}
+	return client.SendRequest(method, url, item, result)
+}
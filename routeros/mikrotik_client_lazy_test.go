@@ -0,0 +1,296 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestLazyClient(t *testing.T) {
+	var connects int
+	c := &lazyClient{
+		ctx:       context.Background(),
+		transport: TransportAPI,
+		version:   "7.19",
+		hosts:     []*url.URL{{Host: "router.lan"}},
+		connect: func(*url.URL) (Client, diag.Diagnostics) {
+			connects++
+			return &testOperationClient{}, diag.Diagnostics{{Severity: diag.Warning, Summary: "REST API is not available"}}
+		},
+	}
+
+	if v := c.GetRouterOSVersion(); v != "7.19" || connects != 0 {
+		t.Fatalf("GetRouterOSVersion() = %v, connects = %v, the configured version without connection is expected", v, connects)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := c.SendRequest(crudRead, &URL{Path: "/system/resource"}, nil, nil); err != nil {
+			t.Fatalf("SendRequest() error = %v", err)
+		}
+	}
+	if connects != 1 {
+		t.Errorf("connects = %v, want 1", connects)
+	}
+
+	if got := c.GetTransport(); got != TransportREST {
+		t.Errorf("GetTransport() = %v, the transport of the connected client is expected", got)
+	}
+}
+
+func TestLazyClient_ConnectError(t *testing.T) {
+	var connects int
+	c := &lazyClient{
+		ctx:       context.Background(),
+		transport: TransportREST,
+		hosts:     []*url.URL{{Host: "router.lan"}},
+		connect: func(*url.URL) (Client, diag.Diagnostics) {
+			connects++
+			return nil, diag.Errorf("router is unreachable")
+		},
+	}
+
+	if err := c.SendRequest(crudRead, &URL{Path: "/system/resource"}, nil, nil); err == nil || err.Error() != "router is unreachable" {
+		t.Errorf("SendRequest() error = %v, want the connection error", err)
+	}
+
+	if got := c.GetTransport(); got != TransportREST {
+		t.Errorf("GetTransport() = %v, the configured transport is expected", got)
+	}
+
+	if got := c.GetRouterOSVersion(); got != "" {
+		t.Errorf("GetRouterOSVersion() = %v, want an empty version", got)
+	}
+
+	// The router is reachable again.
+	c.connect = func(*url.URL) (Client, diag.Diagnostics) {
+		connects++
+		return &testOperationClient{}, nil
+	}
+	if err := c.SendRequest(crudRead, &URL{Path: "/system/resource"}, nil, nil); err != nil || connects != 4 {
+		t.Errorf("SendRequest() error = %v, connects = %v, the request after the failure must connect again", err, connects)
+	}
+}
+
+type testFailoverClient struct {
+	testOperationClient
+	host     string
+	err      error
+	requests int
+	closed   bool
+}
+
+func (c *testFailoverClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *testFailoverClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return c.SendRequestContext(context.Background(), method, url, item, result)
+}
+
+func (c *testFailoverClient) SendRequestContext(context.Context, crudMethod, *URL, MikrotikItem, interface{}) error {
+	c.requests++
+	return c.err
+}
+
+func TestLazyClient_Failover(t *testing.T) {
+	clients := map[string]*testFailoverClient{
+		"vip":     {host: "vip", err: syscall.ECONNRESET},
+		"router1": {host: "router1"},
+	}
+
+	var dials []string
+	c := &lazyClient{
+		ctx:   context.Background(),
+		hosts: []*url.URL{{Host: "vip"}, {Host: "unreachable"}, {Host: "router1"}},
+		connect: func(u *url.URL) (Client, diag.Diagnostics) {
+			dials = append(dials, u.Host)
+			if client, ok := clients[u.Host]; ok {
+				return client, nil
+			}
+			return nil, diag.FromErr(syscall.ECONNREFUSED)
+		},
+	}
+
+	if err := c.SendRequest(crudRead, &URL{Path: "/system/resource"}, nil, nil); err != nil {
+		t.Fatalf("SendRequest() error = %v", err)
+	}
+
+	if want := []string{"vip", "unreachable", "router1"}; !reflect.DeepEqual(dials, want) {
+		t.Errorf("connections = %v, want %v", dials, want)
+	}
+
+	if client, _ := c.getClient(); client != clients["router1"] {
+		t.Errorf("the client is not failed over to router1")
+	}
+	if !clients["vip"].closed || clients["router1"].closed {
+		t.Errorf("the failed client is not closed")
+	}
+
+	// Not a connection error.
+	clients["router1"].err = errors.New("no such item")
+	if err := c.SendRequest(crudRead, &URL{Path: "/system/resource"}, nil, nil); err == nil || len(dials) != 3 {
+		t.Errorf("SendRequest() error = %v, connections = %v, failover is not expected", err, dials)
+	}
+}
+
+func TestLazyClient_FailoverCreate(t *testing.T) {
+	clients := map[string]*testFailoverClient{
+		"vip":     {host: "vip", err: syscall.ECONNRESET},
+		"router1": {host: "router1"},
+	}
+
+	c := &lazyClient{
+		ctx:   context.Background(),
+		hosts: []*url.URL{{Host: "vip"}, {Host: "router1"}},
+		connect: func(u *url.URL) (Client, diag.Diagnostics) {
+			return clients[u.Host], nil
+		},
+	}
+
+	// The create may have been executed by the failed router, it is not sent again.
+	err := c.SendRequest(crudCreate, &URL{Path: "/ip/address"}, MikrotikItem{"address": "10.0.0.1/24"}, nil)
+	if !errors.Is(err, syscall.ECONNRESET) {
+		t.Fatalf("SendRequest() error = %v, want the original error", err)
+	}
+	if client, _ := c.getClient(); client != clients["router1"] || clients["router1"].requests != 0 {
+		t.Errorf("the client is not failed over or the create is repeated")
+	}
+
+	// The router refused the connection, the create has not been sent.
+	clients["vip"].err, clients["router1"].err = nil, syscall.ECONNREFUSED
+	if err := c.SendRequest(crudCreate, &URL{Path: "/ip/address"}, nil, nil); err != nil {
+		t.Fatalf("SendRequest() error = %v", err)
+	}
+	if client, _ := c.getClient(); client != clients["vip"] {
+		t.Errorf("the client is not failed over to vip")
+	}
+}
+
+func TestLazyClient_Relogin(t *testing.T) {
+	password := "old"
+	var dials, relogins int
+	var clients []*testFailoverClient
+
+	c := &lazyClient{
+		ctx:   context.Background(),
+		hosts: []*url.URL{{Host: "router.lan"}},
+		connect: func(*url.URL) (Client, diag.Diagnostics) {
+			dials++
+			client := &testFailoverClient{}
+			if password != "new" {
+				client.err = fmt.Errorf("GET 'https://router.lan/rest/ip/address' returned response code: 401: %w", errRestUnauthorized)
+			}
+			clients = append(clients, client)
+			return client, nil
+		},
+		credentials: func() error {
+			relogins++
+			password = "new"
+			return nil
+		},
+	}
+
+	if err := c.SendRequest(crudRead, &URL{Path: "/ip/address"}, nil, nil); err != nil {
+		t.Fatalf("SendRequest() error = %v", err)
+	}
+	if dials != 2 || relogins != 1 {
+		t.Errorf("connections = %v, relogins = %v, want 2 and 1", dials, relogins)
+	}
+	if !clients[0].closed || clients[1].closed {
+		t.Errorf("the client of the rejected credentials is not closed")
+	}
+
+	// The error is returned if the new credentials are rejected as well.
+	password = "wrong"
+	c.credentials = func() error { return nil }
+	c.client = &testFailoverClient{err: errors.New("invalid user name or password (6)")}
+	if err := c.SendRequest(crudRead, &URL{Path: "/ip/address"}, nil, nil); !isAuthError(err) {
+		t.Errorf("SendRequest() error = %v, want the authentication error", err)
+	}
+}
+
+func TestLazyClient_Reboot(t *testing.T) {
+	defer func(interval time.Duration) { rebootPollInterval = interval }(rebootPollInterval)
+	rebootPollInterval = time.Millisecond
+
+	var dials int
+	c := &lazyClient{
+		ctx:   context.Background(),
+		extra: &ExtraParams{RebootWaitTimeout: time.Second},
+		hosts: []*url.URL{{Host: "router"}},
+		connect: func(*url.URL) (Client, diag.Diagnostics) {
+			dials++
+			switch {
+			case dials == 1:
+				// The connection is closed by the reboot.
+				return &testFailoverClient{err: syscall.ECONNRESET}, nil
+			case dials < 4:
+				return nil, diag.FromErr(syscall.ECONNREFUSED)
+			}
+			return &testFailoverClient{}, nil
+		},
+	}
+
+	if err := c.SendRequest(crudApplyChanges, &URL{Path: "/system/package"}, MikrotikItem{}, nil); err != nil {
+		t.Fatalf("SendRequest(apply-changes) error = %v", err)
+	}
+	if err := c.SendRequest(crudRead, &URL{Path: "/system/package"}, nil, nil); err != nil {
+		t.Fatalf("SendRequest() after reboot error = %v", err)
+	}
+	if dials != 4 {
+		t.Errorf("connections = %v, want 4", dials)
+	}
+
+	// The create may have been executed before the connection was closed, it is not sent again.
+	c.rebootStarted()
+	rebooted := &testFailoverClient{}
+	c.client = &testFailoverClient{err: syscall.ECONNRESET}
+	c.connect = func(*url.URL) (Client, diag.Diagnostics) { return rebooted, nil }
+	err := c.SendRequest(crudCreate, &URL{Path: "/ip/address"}, MikrotikItem{"address": "10.0.0.1/24"}, nil)
+	if !errors.Is(err, syscall.ECONNRESET) || rebooted.requests != 0 {
+		t.Errorf("SendRequest(create) error = %v, requests = %v, want the original error", err, rebooted.requests)
+	}
+	if client, _ := c.getClient(); client != rebooted {
+		t.Errorf("the client is not connected again after the reboot")
+	}
+
+	// The router has not returned.
+	c.rebootAt = time.Now().Add(-990 * time.Millisecond)
+	c.client = &testFailoverClient{err: syscall.ECONNRESET}
+	c.connect = func(*url.URL) (Client, diag.Diagnostics) {
+		return nil, diag.FromErr(syscall.ECONNREFUSED)
+	}
+	err = c.SendRequest(crudRead, &URL{Path: "/system/package"}, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "has not returned after the reboot") {
+		t.Errorf("SendRequest() error = %v, want the reboot wait timeout", err)
+	}
+}
+
+func TestIsRebootRequest(t *testing.T) {
+	tests := []struct {
+		method crudMethod
+		path   string
+		want   bool
+	}{
+		{crudApplyChanges, "/system/package", true},
+		{crudApplyChanges, "/system/package/apply-changes", true},
+		{crudExecute, "/system/reboot", true},
+		{crudPost, "/system/package/update/install", true},
+		{crudRead, "/system/package", false},
+		{crudApplyChanges, "/interface/wifi/capsman", false},
+	}
+	for _, tt := range tests {
+		if got := isRebootRequest(tt.method, &URL{Path: tt.path}); got != tt.want {
+			t.Errorf("isRebootRequest(%v, %v) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
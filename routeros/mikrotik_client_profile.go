@@ -0,0 +1,63 @@
+package routeros
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider attributes that can be set from the named profile: ROS_HOSTURL_core1, ROS_USERNAME_core1, etc.
+var profileAttributes = map[string]string{
+	"hosturl":                "ROS_HOSTURL",
+	"username":               "ROS_USERNAME",
+	"password":               "ROS_PASSWORD",
+	"ca_certificate":         "ROS_CA_CERTIFICATE",
+	"tls_client_certificate": "ROS_TLS_CLIENT_CERTIFICATE",
+	"tls_client_key":         "ROS_TLS_CLIENT_KEY",
+	"tls_fingerprint":        "ROS_TLS_FINGERPRINT",
+	"insecure":               "ROS_INSECURE",
+	"routeros_version":       "ROS_VERSION",
+}
+
+// applyProfile Sets the provider attributes from the environment variables of the named profile.
+// Attributes defined in the provider configuration take precedence over the profile.
+func applyProfile(d *schema.ResourceData) error {
+	profile := d.Get("profile").(string)
+	if profile == "" {
+		return nil
+	}
+
+	var found bool
+	for attr, env := range profileAttributes {
+		v, ok := os.LookupEnv(env + "_" + profile)
+		if !ok {
+			continue
+		}
+		found = true
+
+		if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr(attr).IsNull() {
+			continue
+		}
+
+		var value interface{} = v
+		if _, ok := d.Get(attr).(bool); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("wrong value of %v_%v: %v", env, profile, err)
+			}
+			value = b
+		}
+
+		if err := d.Set(attr, value); err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("no environment variables found for the profile '%v'", profile)
+	}
+
+	return nil
+}
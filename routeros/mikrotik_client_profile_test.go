@@ -0,0 +1,85 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestApplyProfile(t *testing.T) {
+	t.Setenv("ROS_HOSTURL", "https://default.example.com")
+	t.Setenv("ROS_USERNAME", "admin")
+	t.Setenv("ROS_HOSTURL_core1", "apis://core1.example.com")
+	t.Setenv("ROS_USERNAME_core1", "terraform")
+	t.Setenv("ROS_INSECURE_core1", "true")
+
+	tests := []struct {
+		name    string
+		config  map[string]string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			"No profile",
+			map[string]string{},
+			map[string]interface{}{"hosturl": "https://default.example.com", "username": "admin", "insecure": false},
+			false,
+		},
+		{
+			"Profile",
+			map[string]string{"profile": "core1"},
+			map[string]interface{}{"hosturl": "apis://core1.example.com", "username": "terraform", "insecure": true},
+			false,
+		},
+		{
+			"Provider attributes take precedence",
+			map[string]string{"profile": "core1", "username": "operator"},
+			map[string]interface{}{"hosturl": "apis://core1.example.com", "username": "operator", "insecure": true},
+			false,
+		},
+		{
+			"Unknown profile",
+			map[string]string{"profile": "core2"},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Provider()
+			p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				err := applyProfile(d)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("applyProfile() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				for k, v := range tt.want {
+					if got := d.Get(k); got != v {
+						t.Errorf("applyProfile() %v = %v, want %v", k, got, v)
+					}
+				}
+				return nil, nil
+			}
+
+			block := schema.InternalMap(p.Schema).CoreConfigSchema()
+			config := map[string]cty.Value{}
+			for name, ty := range block.ImpliedType().AttributeTypes() {
+				if v, ok := tt.config[name]; ok {
+					config[name] = cty.StringVal(v)
+				} else {
+					config[name] = cty.NullVal(ty)
+				}
+			}
+
+			// The same way as the plugin server passes the configuration.
+			c := terraform.NewResourceConfigShimmed(cty.ObjectVal(config), block)
+			c.CtyValue = cty.ObjectVal(config)
+
+			p.Configure(context.Background(), c)
+		})
+	}
+}
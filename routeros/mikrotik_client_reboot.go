@@ -0,0 +1,76 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// The interval of the connection attempts while the router is rebooting.
+var rebootPollInterval = 5 * time.Second
+
+// Requests that reboot the router.
+var rebootPaths = []string{
+	"/system/reboot",
+	"/system/package/update/install",
+	"/system/package/apply-changes",
+	"/system/package/update/downgrade",
+}
+
+// isRebootRequest Returns true if the request reboots the router.
+func isRebootRequest(method crudMethod, url *URL) bool {
+	if method == crudApplyChanges && strings.HasPrefix(url.Path, "/system/package") {
+		return true
+	}
+
+	return slices.Contains(rebootPaths, url.Path)
+}
+
+// rebootStarted Records the time of the reboot request.
+func (c *lazyClient) rebootStarted() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ColorizedMessage(c.ctx, WARN, fmt.Sprintf("The router is rebooting, the requests wait up to %v for it to return",
+		c.extra.RebootWaitTimeout))
+	c.rebootAt = time.Now()
+}
+
+// rebootPending Returns true if the router has been rebooted within the wait timeout.
+func (c *lazyClient) rebootPending() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return !c.rebootAt.IsZero() && time.Since(c.rebootAt) < c.extra.RebootWaitTimeout
+}
+
+// waitReboot Connects to the router again after the reboot. The connection attempts are repeated
+// until the wait timeout, the other requests wait for the connection as well.
+func (c *lazyClient) waitReboot(ctx context.Context, failed Client) (Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != failed && c.client != nil {
+		return c.client, nil
+	}
+
+	deadline := c.rebootAt.Add(c.extra.RebootWaitTimeout)
+	for {
+		if err := sleepContext(ctx, rebootPollInterval); err != nil {
+			return nil, err
+		}
+
+		c.redial(c.current)
+		if c.err == nil {
+			ColorizedMessage(c.ctx, INFO, "The router is back after the reboot")
+			return c.client, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("the router has not returned after the reboot within %v: %w",
+				c.extra.RebootWaitTimeout, c.err)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -18,9 +19,18 @@ type RestClient struct {
 	Password  string
 	Transport TransportType
 	extra     *ExtraParams
+	version   string
+	userAgent string
+	headers   map[string]string
 	*http.Client
 }
 
+const restUserAgent = "terraform-provider-routeros"
+
+var errRestNotFound = errors.New("REST API not found")
+
+var errRestUnauthorized = errors.New("authentication failed")
+
 type errorResponse struct {
 	Detail  string `json:"detail"`
 	Error   int    `json:"error"`
@@ -29,20 +39,30 @@ type errorResponse struct {
 
 var (
 	restMethodName = map[crudMethod]string{
-		crudCreate:      "PUT",
-		crudRead:        "GET",
-		crudUpdate:      "PATCH",
-		crudDelete:      "DELETE",
-		crudPost:        "POST",
-		crudImport:      "POST",
-		crudSign:        "POST",
-		crudSignViaScep: "POST",
-		crudRemove:      "POST",
-		crudRevoke:      "POST",
-		crudMove:        "POST",
-		crudStart:       "POST",
-		crudStop:        "POST",
-		crudGenerateKey: "POST",
+		crudCreate:           "PUT",
+		crudRead:             "GET",
+		crudUpdate:           "PATCH",
+		crudDelete:           "DELETE",
+		crudPost:             "POST",
+		crudImport:           "POST",
+		crudSign:             "POST",
+		crudSignViaScep:      "POST",
+		crudRemove:           "POST",
+		crudRevoke:           "POST",
+		crudMove:             "POST",
+		crudStart:            "POST",
+		crudStop:             "POST",
+		crudGenerateKey:      "POST",
+		crudActivate:         "POST",
+		crudEnable:           "POST",
+		crudDisable:          "POST",
+		crudApplyChanges:     "POST",
+		crudDeviceModeUpdate: "POST",
+		crudCheckForUpdates:  "POST",
+		crudMonitor:          "POST",
+		crudExecute:          "POST",
+		crudPrint:            "POST",
+		crudRunScript:        "POST",
 	}
 )
 
@@ -54,11 +74,43 @@ func (c *RestClient) GetTransport() TransportType {
 	return c.Transport
 }
 
+func (c *RestClient) GetRouterOSVersion() string {
+	return c.version
+}
+
 func (c *RestClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	// The provider configuration context is only used for logging, it can be cancelled after configuration.
+	return c.SendRequestContext(context.WithoutCancel(c.ctx), method, url, item, result)
+}
+
+// SendRequestContext Sends the request within the context of the Terraform operation:
+// the operation timeout and tracing.
+func (c *RestClient) SendRequestContext(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return doRequest(ctx, c.extra, c.Transport, method, url, item, func(ctx context.Context) error {
+		return c.sendRequest(ctx, method, url, item, result)
+	})
+}
+
+func (c *RestClient) sendRequest(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
 	var data io.Reader
+	var reqBody any
 
 	if item != nil {
-		b, err := json.Marshal(&item)
+		reqBody = item
+	}
+
+	// https://mikrotik + /rest + /interface/vlan + ? + .id=*39
+	// Escaping spaces!
+	requestUrl := c.HostURL + "/rest" + strings.Replace(url.GetRestURL(), " ", "%20", -1)
+
+	if method == crudPrint {
+		// POST /rest/ip/firewall/address-list/print {".query": [".id=*1", ".id=*2", "#|"], ".proplist": "address"}
+		requestUrl = c.HostURL + "/rest" + strings.Replace(url.Path, " ", "%20", -1) + "/print"
+		reqBody = restPrintBody(url, item)
+	}
+
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
 		if err != nil {
 			return err
 		}
@@ -67,16 +119,19 @@ func (c *RestClient) SendRequest(method crudMethod, url *URL, item MikrotikItem,
 		data = bytes.NewBuffer(b)
 	}
 
-	// https://mikrotik + /rest + /interface/vlan + ? + .id=*39
-	// Escaping spaces!
-	requestUrl := c.HostURL + "/rest" + strings.Replace(url.GetRestURL(), " ", "%20", -1)
 	ColorizedDebug(c.ctx, restMethodName[method]+" request URL:  "+requestUrl)
 
-	req, err := http.NewRequest(restMethodName[method], requestUrl, data)
+	req, err := http.NewRequestWithContext(ctx, restMethodName[method], requestUrl, data)
 	if err != nil {
 		return err
 	}
 
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 	req.Header.Set("Content-Type", "application/json")
 	req.SetBasicAuth(c.Username, c.Password)
 
@@ -86,6 +141,7 @@ func (c *RestClient) SendRequest(method crudMethod, url *URL, item MikrotikItem,
 	}
 
 	defer func() { _ = res.Body.Close() }()
+	setRequestStatus(ctx, res.StatusCode)
 
 	body, _ := io.ReadAll(res.Body)
 
@@ -94,6 +150,16 @@ func (c *RestClient) SendRequest(method crudMethod, url *URL, item MikrotikItem,
 
 		ColorizedDebug(c.ctx, fmt.Sprintf("error response body:\n%s", body))
 
+		// RouterOS 6 web server: there is no REST API.
+		if res.StatusCode == http.StatusNotFound && !json.Valid(body) {
+			return fmt.Errorf("%v '%v': %w", restMethodName[method], requestUrl, errRestNotFound)
+		}
+
+		if res.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("%v '%v' returned response code: %v: %w", restMethodName[method], requestUrl,
+				res.StatusCode, errRestUnauthorized)
+		}
+
 		if err = json.Unmarshal(body, &errRes); err != nil {
 			return fmt.Errorf("json.Unmarshal - %v", err)
 		} else {
@@ -140,6 +206,20 @@ func (c *RestClient) SendRequest(method crudMethod, url *URL, item MikrotikItem,
 	return nil
 }
 
+// restPrintBody Returns the body of the print command, the URL query words are sent in the '.query' list.
+func restPrintBody(url *URL, item MikrotikItem) map[string]any {
+	body := map[string]any{}
+	for k, v := range item {
+		body[k] = v
+	}
+
+	if len(url.Query) > 0 {
+		body[".query"] = url.Query
+	}
+
+	return body
+}
+
 // isSlice The function returns information whether the passed parameter is a slice.
 // The incoming type is a variable or pointer.
 func isSlice(i any) bool {
@@ -0,0 +1,156 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RouterOS replies of temporary failures, the request has not been executed and can be repeated.
+var retryableMessages = []string{
+	"busy",
+	"try again",
+}
+
+// Messages of the failures after the request was sent, only the idempotent requests are repeated.
+var retryableIdempotentMessages = []string{
+	"timed out",
+}
+
+// RouterOS messages of the flash write contention: many writes to a slow NAND.
+// These requests are always repeated after a short delay.
+var busyMessages = []string{
+	"already have such entry being created",
+	"busy",
+}
+
+const busyMaxRetries = 5
+
+var busyBackoff = 200 * time.Millisecond
+
+// sendWithRetry Repeats the request on transient errors with an exponential backoff.
+// The number of attempts and the initial delay are taken from the provider settings.
+// Busy responses are repeated a few times regardless of the settings.
+// The requests that are not idempotent (create, move, ...) may have been executed by the router before the
+// connection failed, they are only repeated if the request has not reached the router.
+func sendWithRetry(ctx context.Context, extra *ExtraParams, method crudMethod, send func() error) error {
+	var retries, busyRetries int
+	var backoff time.Duration
+	if extra != nil {
+		backoff = extra.RetryBackoff
+	}
+
+	for {
+		err := send()
+
+		switch {
+		case isBusyError(err) && busyRetries < busyMaxRetries:
+			busyRetries++
+			delay := busyBackoff * time.Duration(busyRetries)
+			ColorizedMessage(ctx, WARN, fmt.Sprintf("RouterOS is busy, retry %d of %d in %v: %v",
+				busyRetries, busyMaxRetries, delay, err))
+
+			if err := sleepContext(ctx, delay); err != nil {
+				return err
+			}
+		case extra != nil && retries < extra.MaxRetries && isRetryableError(method, err):
+			retries++
+			ColorizedMessage(ctx, WARN, fmt.Sprintf("Request failed, retry %d of %d in %v: %v",
+				retries, extra.MaxRetries, backoff, err))
+
+			if err := sleepContext(ctx, backoff); err != nil {
+				return err
+			}
+			backoff *= 2
+		default:
+			return err
+		}
+	}
+}
+
+// sleepContext Waits for the delay or until the operation context is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func isBusyError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range busyMessages {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRetryableError(method crudMethod, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if isNotSentError(err) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range retryableMessages {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+
+	if !isIdempotent(method) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	for _, s := range retryableIdempotentMessages {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNotSentError Returns true if the request failed before it was sent: the connection is refused or cannot be
+// established.
+func isNotSentError(err error) bool {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
@@ -0,0 +1,82 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("no route to host")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+
+	tests := []struct {
+		name   string
+		method crudMethod
+		err    error
+		want   bool
+	}{
+		{"No error", crudRead, nil, false},
+		{"Connection reset", crudRead, fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"RouterOS busy", crudRead, errors.New("from RouterOS device: failure: item is busy"), true},
+		{"RouterOS action timed out", crudRead, errors.New("action timed out - try again"), true},
+		{"Wrong value", crudRead, errors.New("from RouterOS device: input does not match any value of interface"), false},
+		{"Create connection reset", crudCreate, readErr, false},
+		{"Create unexpected EOF", crudCreate, io.ErrUnexpectedEOF, false},
+		{"Create timed out", crudCreate, errors.New("i/o timed out"), false},
+		{"Move connection reset", crudMove, fmt.Errorf("read: %w", syscall.ECONNRESET), false},
+		{"Create connection refused", crudCreate, fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{"Create dial error", crudCreate, dialErr, true},
+		{"Create RouterOS busy", crudCreate, errors.New("from RouterOS device: failure: item is busy"), true},
+		{"Update connection reset", crudUpdate, readErr, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.method, tt.err); got != tt.want {
+				t.Errorf("isRetryableError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendWithRetry(t *testing.T) {
+	defer func(d time.Duration) { busyBackoff = d }(busyBackoff)
+	busyBackoff = time.Millisecond
+
+	retries := &ExtraParams{MaxRetries: 2, RetryBackoff: time.Millisecond}
+
+	tests := []struct {
+		name      string
+		extra     *ExtraParams
+		method    crudMethod
+		err       error
+		wantCalls int
+	}{
+		{"Retries disabled", nil, crudRead, syscall.ECONNRESET, 1},
+		{"Transient error", retries, crudRead, syscall.ECONNRESET, 3},
+		{"Permanent error", retries, crudRead, errors.New("no such item"), 1},
+		{"Busy without retries", nil, crudCreate, errors.New("failure: already have such entry being created"), busyMaxRetries + 1},
+		{"Create after connection reset", retries, crudCreate, syscall.ECONNRESET, 1},
+		{"Create after connection refused", retries, crudCreate, syscall.ECONNREFUSED, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			err := sendWithRetry(context.Background(), tt.extra, tt.method, func() error {
+				calls++
+				return tt.err
+			})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("sendWithRetry() error = %v, want %v", err, tt.err)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("sendWithRetry() calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
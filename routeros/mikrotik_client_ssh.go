@@ -0,0 +1,409 @@
+package routeros
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"golang.org/x/net/proxy"
+)
+
+type SshClient struct {
+	ctx       context.Context
+	HostURL   string
+	Username  string
+	Password  string
+	Transport TransportType
+	extra     *ExtraParams
+	version   string
+	*ssh.Client
+}
+
+var (
+	// Arguments that are passed as flags (without a value) on the command line.
+	sshFlagArgs = map[string]struct{}{
+		"as-string":      {},
+		"once":           {},
+		"without-paging": {},
+	}
+
+	reSshId     = regexp.MustCompile(`^\*[0-9A-F]+$`)
+	reSshNumber = regexp.MustCompile(`^-?[0-9]+$`)
+
+	sshEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		`$`, `\$`,
+		`?`, `\?`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	)
+)
+
+const sshErrorPrefix = "!error="
+
+// sshHostKeyCallback Returns the host key check against ~/.ssh/known_hosts.
+func sshHostKeyCallback(insecure bool) (ssh.HostKeyCallback, error) {
+	if insecure {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return knownhosts.New(filepath.Join(home, ".ssh", "known_hosts"))
+}
+
+// dialSsh Establishes the SSH connection using the dialer (direct, SOCKS5 or another SSH connection).
+func dialSsh(dialer proxy.Dialer, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	if dialer == proxy.Direct {
+		return ssh.Dial("tcp", addr, config)
+	}
+
+	conn, err := dialer.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// dialBastion Connects to the SSH jump host using the private key.
+// The key can be passed as a file path or PEM content.
+func dialBastion(host, user, privateKey string, insecure bool) (*ssh.Client, error) {
+	if user == "" || privateKey == "" {
+		return nil, fmt.Errorf("bastion_user and bastion_private_key must be specified")
+	}
+
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+
+	key, err := readPem(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	hostKeyCallback, err := sshHostKeyCallback(insecure)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.Dial("tcp", host, &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         time.Minute,
+	})
+}
+
+func (c *SshClient) GetExtraParams() *ExtraParams {
+	return c.extra
+}
+
+func (c *SshClient) GetTransport() TransportType {
+	return c.Transport
+}
+
+func (c *SshClient) GetRouterOSVersion() string {
+	return c.version
+}
+
+func (c *SshClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	// The provider configuration context is only used for logging, it can be cancelled after configuration.
+	return c.SendRequestContext(context.WithoutCancel(c.ctx), method, url, item, result)
+}
+
+// SendRequestContext Sends the request within the context of the Terraform operation:
+// the operation timeout and tracing.
+func (c *SshClient) SendRequestContext(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return doRequest(ctx, c.extra, c.Transport, method, url, item, func(ctx context.Context) error {
+		return c.sendRequest(ctx, method, url, item, result)
+	})
+}
+
+func (c *SshClient) sendRequest(_ context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+
+	// https://help.mikrotik.com/docs/display/ROS/Scripting
+	// The request is built in the same way as for the API and converted into a CLI command:
+	// /interface/vlan/print + '?.id=*39' => /interface/vlan/print as-value where .id=*39
+	cmd := sshCommand(method, url, item, result)
+	ColorizedDebug(c.ctx, "request body:  "+cmd)
+
+	session, err := c.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+
+	if err = session.Run(cmd); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	resp := strings.TrimSpace(stdout.String())
+	ColorizedDebug(c.ctx, "response body: "+resp)
+
+	if strings.HasPrefix(resp, sshErrorPrefix) {
+		return fmt.Errorf("%s", strings.TrimPrefix(resp, sshErrorPrefix))
+	}
+
+	// Syntax errors are not caught by ':onerror'.
+	if strings.Contains(resp, "syntax error") || strings.HasPrefix(resp, "bad command name") ||
+		strings.HasPrefix(resp, "expected ") {
+		return fmt.Errorf("%s", resp)
+	}
+
+	if result == nil || (method != crudCreate && !sshSerialized(method, result)) {
+		return nil
+	}
+
+	// Unmarshal
+
+	switch r := result.(type) {
+	case *MikrotikItem:
+		// Only ID returned.
+		if method == crudCreate {
+			if resp != "" {
+				(*r)["ret"] = resp
+			}
+			break
+		}
+
+		items, err := sshUnmarshal(resp)
+		if err != nil {
+			return err
+		}
+
+		// Fill in only one item.
+		if len(items) > 0 {
+			for k, v := range items[0] {
+				(*r)[k] = v
+			}
+		}
+	case *[]MikrotikItem:
+		items, err := sshUnmarshal(resp)
+		if err != nil {
+			return err
+		}
+
+		*r = append(*r, items...)
+	default:
+		panic("[SendRequest] type " + reflect.TypeOf(result).String() + " is not supported for SSH response unmarshaling.")
+	}
+
+	return nil
+}
+
+// sshCommand Converts the API request into a RouterOS CLI command.
+// Errors are caught by the script and printed with the '!error=' prefix.
+func sshCommand(method crudMethod, url *URL, item MikrotikItem, result interface{}) string {
+	var args, where []string
+
+	// API query: ?name=value; ?=name=value; ?#|
+	// API attributes: =name=value
+	for _, q := range url.Query {
+		switch {
+		case strings.HasPrefix(q, "?="):
+			where = append(where, sshFilter(strings.SplitN(q[2:], "=", 2)))
+		case strings.HasPrefix(q, "?#"):
+			where = sshStackOp(where, q[2:])
+		case strings.HasPrefix(q, "?"):
+			where = append(where, sshFilter(strings.SplitN(q[1:], "=", 2)))
+		case strings.HasPrefix(q, "="):
+			args = append(args, sshArg(strings.SplitN(q[1:], "=", 2)))
+		}
+	}
+
+	// Sort attributes to get stable commands.
+	keys := make([]string, 0, len(item))
+	for k := range item {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		args = append(args, sshArg([]string{k, item[k]}))
+	}
+
+	cmd := url.Path + apiMethodName[method]
+
+	if len(args) > 0 {
+		cmd += " " + strings.Join(args, " ")
+	}
+
+	if sshSerialized(method, result) {
+		cmd += " as-value"
+	}
+
+	if len(where) > 0 {
+		cmd += " where " + strings.Join(where, " ")
+	}
+
+	switch {
+	case method == crudCreate:
+		// The ID of the created item.
+		cmd = ":put [" + cmd + "]"
+	case sshSerialized(method, result):
+		cmd = ":put [:serialize to=json value=[" + cmd + "]]"
+	}
+
+	return ":onerror e in={ " + cmd + " } do={ :put (\"" + sshErrorPrefix + "\" . $e) }"
+}
+
+// sshStackOp Applies the API query stack operation to the CLI expressions:
+// '|' - (a or b), '&' - (a and b), '!' - !(a).
+// The expressions left on the stack are joined by 'and' as in the API.
+func sshStackOp(stack []string, op string) []string {
+	switch n := len(stack); {
+	case op == "!" && n > 0:
+		stack[n-1] = "!(" + stack[n-1] + ")"
+	case op == "|" && n > 1:
+		stack = append(stack[:n-2], "("+stack[n-2]+" or "+stack[n-1]+")")
+	case op == "&" && n > 1:
+		stack = append(stack[:n-2], "("+stack[n-2]+" and "+stack[n-1]+")")
+	}
+	return stack
+}
+
+// sshSerialized Returns true if the command output is requested as JSON.
+func sshSerialized(method crudMethod, result interface{}) bool {
+	switch method {
+	case crudCreate:
+		return false
+	case crudRead, crudMonitor, crudExecute:
+		return result != nil
+	}
+
+	_, ok := result.(*[]MikrotikItem)
+	return ok
+}
+
+// sshArg Converts 'name=value' into a CLI argument.
+func sshArg(kv []string) string {
+	// The CLI uses 'numbers' instead of '.id' to select items.
+	if kv[0] == ".id" {
+		kv[0] = "numbers"
+	}
+
+	// print proplist=name1,name2
+	if kv[0] == ".proplist" && len(kv) == 2 {
+		return "proplist=" + kv[1]
+	}
+
+	if _, ok := sshFlagArgs[kv[0]]; ok && len(kv) == 2 && kv[1] == "" {
+		return kv[0]
+	}
+
+	return sshFilter(kv)
+}
+
+// sshFilter Converts 'name=value' into a CLI expression.
+// The API query words '>name=value', '<name=value' and '-name' are converted into 'name>value', 'name<value'
+// and '!name'.
+func sshFilter(kv []string) string {
+	if len(kv) == 1 {
+		if strings.HasPrefix(kv[0], "-") {
+			return "!" + kv[0][1:]
+		}
+		return kv[0]
+	}
+
+	op := "="
+	if strings.HasPrefix(kv[0], ">") || strings.HasPrefix(kv[0], "<") {
+		op, kv[0] = kv[0][:1], kv[0][1:]
+
+		// Numbers are compared as numbers.
+		if reSshNumber.MatchString(kv[1]) {
+			return kv[0] + op + kv[1]
+		}
+	}
+
+	// Item IDs are not quoted.
+	if reSshId.MatchString(kv[1]) {
+		return kv[0] + op + kv[1]
+	}
+
+	return kv[0] + op + "\"" + sshEscaper.Replace(kv[1]) + "\""
+}
+
+// sshUnmarshal Converts the JSON output of ':serialize' into a list of items.
+// Values are converted into strings in the same format as the REST API.
+func sshUnmarshal(resp string) ([]MikrotikItem, error) {
+	if resp == "" || resp == "null" {
+		return nil, nil
+	}
+
+	// A single item (/system/resource) is serialized as an object.
+	if resp[0] == '{' {
+		resp = "[" + resp + "]"
+	}
+
+	var data []map[string]interface{}
+
+	dec := json.NewDecoder(strings.NewReader(resp))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal SSH response: %v", err)
+	}
+
+	var res = make([]MikrotikItem, 0, len(data))
+	for _, m := range data {
+		item := MikrotikItem{}
+		for k, v := range m {
+			item[k] = sshValue(v)
+		}
+		res = append(res, item)
+	}
+
+	return res, nil
+}
+
+func sshValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return fmt.Sprint(v)
+	case json.Number:
+		return v.String()
+	case []interface{}:
+		var s = make([]string, len(v))
+		for i := range v {
+			s[i] = sshValue(v[i])
+		}
+		return strings.Join(s, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
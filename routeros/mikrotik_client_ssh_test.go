@@ -0,0 +1,131 @@
+package routeros
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSshCommand(t *testing.T) {
+	type args struct {
+		method crudMethod
+		url    *URL
+		item   MikrotikItem
+		result interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			"Read item by ID",
+			args{crudRead, &URL{Path: "/interface/vlan", Query: []string{"?.id=*39"}}, nil, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/interface/vlan/print as-value where .id=*39]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Read filtered items",
+			args{crudRead, &URL{Path: "/ip/route", Query: []string{"?=dst-address=0.0.0.0/0"}}, nil, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/ip/route/print as-value where dst-address="0.0.0.0/0"]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Read item attributes",
+			args{crudRead, &URL{Path: "/ip/dhcp-server/lease", Query: []string{"?=server=dhcp1", "=.proplist=.id,address"}}, nil, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/ip/dhcp-server/lease/print proplist=.id,address as-value where server="dhcp1"]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Read items by comparison",
+			args{crudRead, &URL{Path: "/interface", Query: []string{"?>mtu=1500", "?<name=ether5", "?-comment"}}, nil, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/interface/print as-value where mtu>1500 name<"ether5" !comment]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Read items by query operations",
+			args{crudRead, &URL{Path: "/interface", Query: []string{"?>mtu=1500", "?name=ether1", "?#|", "?type=vlan", "?#!", "?#&"}}, nil, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/interface/print as-value where ((mtu>1500 or name="ether1") and !(type="vlan"))]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Read items by filter and query",
+			args{crudRead, &URL{Path: "/interface", Query: []string{"?=running=true", "?type=ether", "?type=vlan", "?#|"}}, nil, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/interface/print as-value where running="true" (type="ether" or type="vlan")]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Create item",
+			args{crudCreate, &URL{Path: "/interface/vlan"}, MikrotikItem{"name": "vlan10", "comment": `"$x?"`}, &MikrotikItem{}},
+			`:onerror e in={ :put [/interface/vlan/add comment="\"\$x\?\"" name="vlan10"] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Update item",
+			args{crudUpdate, &URL{Path: "/interface/vlan"}, MikrotikItem{".id": "*39", "mtu": "1500"}, &MikrotikItem{}},
+			`:onerror e in={ /interface/vlan/set numbers=*39 mtu="1500" } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Delete item",
+			args{crudDelete, &URL{Path: "/interface/vlan", Query: []string{"=.id=*39"}}, nil, &MikrotikItem{}},
+			`:onerror e in={ /interface/vlan/remove numbers=*39 } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Monitor once",
+			args{crudMonitor, &URL{Path: "/interface/ethernet"}, MikrotikItem{"numbers": "ether1", "once": ""}, &[]MikrotikItem{}},
+			`:onerror e in={ :put [:serialize to=json value=[/interface/ethernet/monitor numbers="ether1" once as-value]] } do={ :put ("!error=" . $e) }`,
+		},
+		{
+			"Execute script",
+			args{crudRunScript, &URL{Path: "/execute"}, MikrotikItem{"script": `:do { /ip/firewall/address-list/add address="10.0.0.1" list="l" } on-error={}`, "as-string": ""}, nil},
+			`:onerror e in={ /execute as-string script=":do { /ip/firewall/address-list/add address=\"10.0.0.1\" list=\"l\" } on-error={}" } do={ :put ("!error=" . $e) }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sshCommand(tt.args.method, tt.args.url, tt.args.item, tt.args.result); got != tt.want {
+				t.Errorf("sshCommand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSshUnmarshal(t *testing.T) {
+	tests := []struct {
+		name    string
+		resp    string
+		want    []MikrotikItem
+		wantErr bool
+	}{
+		{
+			"Empty response",
+			"",
+			nil,
+			false,
+		},
+		{
+			"Single item",
+			`{"uptime":"1d","cpu-count":4,"version":"7.19 (stable)"}`,
+			[]MikrotikItem{{"uptime": "1d", "cpu-count": "4", "version": "7.19 (stable)"}},
+			false,
+		},
+		{
+			"List of items",
+			`[{".id":"*1","disabled":false,"topics":["info","debug"]},{".id":"*2","disabled":true}]`,
+			[]MikrotikItem{
+				{".id": "*1", "disabled": "false", "topics": "info,debug"},
+				{".id": "*2", "disabled": "true"},
+			},
+			false,
+		},
+		{
+			"Wrong response",
+			`interrupted`,
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sshUnmarshal(tt.resp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sshUnmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sshUnmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
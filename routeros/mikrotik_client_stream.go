@@ -0,0 +1,136 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// requestStreamer Clients that pass the replies of long-running commands (torch, ping, traffic monitor)
+// as they arrive.
+type requestStreamer interface {
+	StreamRequest(ctx context.Context, url *URL, item MikrotikItem, duration time.Duration,
+		handle func(MikrotikItem) error) error
+}
+
+// StreamItems Runs a long-running command and passes every reply item to the handler.
+// The command is stopped after the duration, when the context is done or the handler returns an error.
+// The zero duration waits until the command finishes by itself.
+// Clients without streaming support send a single request, the command must finish by itself
+// (count=, duration=, etc.) and the items are passed to the handler when the request is completed.
+func StreamItems(ctx context.Context, c Client, url *URL, item MikrotikItem, duration time.Duration,
+	handle func(MikrotikItem) error) error {
+
+	if streamer, ok := c.(requestStreamer); ok {
+		return streamer.StreamRequest(ctx, url, item, duration, handle)
+	}
+
+	var res []MikrotikItem
+	if err := sendClientRequest(ctx, c, crudExecute, url, item, &res); err != nil {
+		return err
+	}
+
+	for _, r := range res {
+		if err := handle(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StreamRequest Passes the '!re' sentences of the command to the handler.
+func (c *ApiClient) StreamRequest(ctx context.Context, url *URL, item MikrotikItem, duration time.Duration,
+	handle func(MikrotikItem) error) error {
+
+	return doRequest(ctx, c.extra, c.Transport, crudExecute, url, item, func(ctx context.Context) error {
+		return c.streamRequest(ctx, url, item, duration, handle)
+	})
+}
+
+func (c *ApiClient) streamRequest(ctx context.Context, url *URL, item MikrotikItem, duration time.Duration,
+	handle func(MikrotikItem) error) error {
+
+	// /tool/torch + =interface=ether1
+	cmd := url.GetApiCmd()
+	for fieldName, fieldValue := range item {
+		cmd = append(cmd, fmt.Sprintf("=%s=%s", fieldName, fieldValue))
+	}
+	ColorizedDebug(c.ctx, "request body:  "+strings.Join(cmd, " "))
+
+	// A listener context cancels the reader of the whole connection, so the command is cancelled
+	// with '/cancel' instead.
+	client := c.getClient()
+	l, err := client.ListenArgs(cmd)
+	if isConnectionLost(err) && c.dial != nil {
+		ColorizedMessage(c.ctx, WARN, "API session lost, reconnecting: "+err.Error())
+		if client, err = c.reconnect(client); err != nil {
+			return err
+		}
+		l, err = client.ListenArgs(cmd)
+	}
+	if err != nil {
+		return err
+	}
+
+	stop := func() {
+		go func() { _, _ = l.Cancel() }()
+		// The replies received before the command is cancelled must be read,
+		// otherwise they block the other requests of the connection.
+		go func() {
+			for range l.Chan() {
+			}
+		}()
+	}
+
+	var timeout <-chan time.Time
+	if duration > 0 {
+		t := time.NewTimer(duration)
+		defer t.Stop()
+		timeout = t.C
+	}
+
+	for {
+		select {
+		case sentence, ok := <-l.Chan():
+			if !ok {
+				return l.Err()
+			}
+			ColorizedDebug(c.ctx, "response body: "+sentence.String())
+
+			m := MikrotikItem{}
+			for k, v := range sentence.Map {
+				m[k] = v
+			}
+
+			if err = handle(m); err != nil {
+				stop()
+				return err
+			}
+		case <-timeout:
+			stop()
+			return nil
+		case <-ctx.Done():
+			stop()
+			return ctx.Err()
+		}
+	}
+}
+
+func (c *lazyClient) StreamRequest(ctx context.Context, url *URL, item MikrotikItem, duration time.Duration,
+	handle func(MikrotikItem) error) error {
+
+	client, err := c.getClient()
+	if err != nil {
+		return err
+	}
+
+	return StreamItems(ctx, client, url, item, duration, handle)
+}
+
+func (c *contextClient) StreamRequest(ctx context.Context, url *URL, item MikrotikItem, duration time.Duration,
+	handle func(MikrotikItem) error) error {
+
+	return StreamItems(ctx, c.Client, url, item, duration, handle)
+}
@@ -0,0 +1,114 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-routeros/routeros/v3"
+	"github.com/go-routeros/routeros/v3/proto"
+)
+
+// testStreamServer Replies to the command with three '!re' sentences and waits for '/cancel'.
+func testStreamServer(conn net.Conn) {
+	r, w := proto.NewReader(conn), proto.NewWriter(conn)
+	write := func(words ...string) {
+		w.BeginSentence()
+		for _, word := range words {
+			w.WriteWord(word)
+		}
+		_ = w.EndSentence()
+	}
+
+	var listenTag string
+	for {
+		sentence, err := r.ReadSentence()
+		if err != nil {
+			return
+		}
+
+		switch sentence.Word {
+		case "/tool/torch":
+			listenTag = sentence.Tag
+			for _, rx := range []string{"100", "200", "300"} {
+				write("!re", "=rx="+rx, ".tag="+listenTag)
+			}
+		case "/cancel":
+			write("!trap", "=category=2", "=message=interrupted", ".tag="+listenTag)
+			write("!done", ".tag="+listenTag)
+			write("!done", ".tag="+sentence.Tag)
+		}
+	}
+}
+
+func TestApiClient_StreamRequest(t *testing.T) {
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name      string
+		duration  time.Duration
+		stopAfter int
+		wantItems int
+		wantErr   error
+	}{
+		{"Duration limit", 100 * time.Millisecond, 0, 3, nil},
+		{"Handler error", 0, 2, 2, errStop},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, srv := net.Pipe()
+			go testStreamServer(srv)
+			defer func() { _ = srv.Close() }()
+
+			client, err := routeros.NewClient(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.Async()
+			defer func() { _ = client.Close() }()
+
+			c := &ApiClient{ctx: context.Background(), Transport: TransportAPI, Client: client}
+
+			var items []MikrotikItem
+			err = StreamItems(context.Background(), c, &URL{Path: "/tool/torch"}, MikrotikItem{"interface": "ether1"},
+				tt.duration, func(item MikrotikItem) error {
+					items = append(items, item)
+					if len(items) == tt.stopAfter {
+						return errStop
+					}
+					return nil
+				})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StreamItems() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(items) != tt.wantItems || items[0]["rx"] != "100" {
+				t.Errorf("StreamItems() items = %v, want %v items", items, tt.wantItems)
+			}
+
+			// The cancelled command does not block the other requests.
+			if _, err = client.Run("/cancel", "=tag=x"); err != nil {
+				t.Errorf("Run() after StreamItems() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestStreamItems_Fallback(t *testing.T) {
+	c := &testOperationClient{}
+
+	var called bool
+	err := StreamItems(context.Background(), c, &URL{Path: "/ping"}, MikrotikItem{"count": "3"}, time.Second,
+		func(MikrotikItem) error {
+			called = true
+			return nil
+		})
+	if err != nil || called {
+		t.Errorf("StreamItems() error = %v, handler called = %v", err, called)
+	}
+	if c.ctx == nil {
+		t.Error("StreamItems() has not sent the request")
+	}
+}
@@ -0,0 +1,89 @@
+package routeros
+
+import "testing"
+
+func TestCheckReadOnly(t *testing.T) {
+	tests := []struct {
+		name    string
+		extra   *ExtraParams
+		method  crudMethod
+		wantErr bool
+	}{
+		{"No parameters", nil, crudCreate, false},
+		{"Read-only disabled", &ExtraParams{}, crudDelete, false},
+		{"Read", &ExtraParams{ReadOnly: true}, crudRead, false},
+		{"Monitor", &ExtraParams{ReadOnly: true}, crudMonitor, false},
+		{"Create", &ExtraParams{ReadOnly: true}, crudCreate, true},
+		{"Update", &ExtraParams{ReadOnly: true}, crudUpdate, true},
+		{"Delete", &ExtraParams{ReadOnly: true}, crudDelete, true},
+		{"Action", &ExtraParams{ReadOnly: true}, crudEnable, true},
+		{"Execute", &ExtraParams{ReadOnly: true}, crudExecute, false},
+		{"Run script", &ExtraParams{ReadOnly: true}, crudRunScript, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkReadOnly(tt.extra, tt.method, &URL{Path: "/ip/address"}); (err != nil) != tt.wantErr {
+				t.Errorf("checkReadOnly() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRouterOSVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		m    interface{}
+		want string
+	}{
+		{"No client", nil, ""},
+		{"REST client", &RestClient{version: "7.16"}, "7.16"},
+		{"API client", &ApiClient{version: "6.49.7"}, "6.49.7"},
+		{"Operation client", &contextClient{Client: &SshClient{version: "7.19"}}, "7.19"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := routerOSVersion(tt.m); got != tt.want {
+				t.Errorf("routerOSVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRouterUrl(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostUrl  string
+		port     string
+		wantHost string
+		wantUrl  string
+	}{
+		{"Host name", "router.lan", "", "router.lan", "https://router.lan"},
+		{"Host name with port", "apis://router.lan:18729", "8729", "router.lan:18729", "apis://router.lan:18729"},
+		{"IPv4 API", "api://192.168.88.1", "8728", "192.168.88.1:8728", "api://192.168.88.1:8728"},
+		{"IPv6 bracketed", "apis://[2001:db8::1]", "8729", "[2001:db8::1]:8729", "apis://[2001:db8::1]:8729"},
+		{"IPv6 bracketed with port", "apis://[2001:db8::1]:18729", "8729", "[2001:db8::1]:18729", "apis://[2001:db8::1]:18729"},
+		{"IPv6 bare", "apis://2001:db8::1", "8729", "[2001:db8::1]:8729", "apis://[2001:db8::1]:8729"},
+		{"IPv6 bare without scheme", "2001:db8::1", "", "[2001:db8::1]", "https://[2001:db8::1]"},
+		{"IPv6 zone", "ssh://[fe80::1%ether1]", "22", "[fe80::1%ether1]:22", "ssh://[fe80::1%25ether1]:22"},
+		{"IPv6 escaped zone", "https://[fe80::1%25ether1]/", "", "[fe80::1%ether1]", "https://[fe80::1%25ether1]/"},
+		{"IPv6 bare zone", "fe80::1%ether1", "", "[fe80::1%ether1]", "https://[fe80::1%25ether1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := parseRouterUrl(tt.hostUrl)
+			if err != nil {
+				t.Fatalf("parseRouterUrl() error = %v", err)
+			}
+			if tt.port != "" {
+				setDefaultPort(u, tt.port)
+			}
+
+			if u.Host != tt.wantHost {
+				t.Errorf("parseRouterUrl() host = %v, want %v", u.Host, tt.wantHost)
+			}
+			if u.String() != tt.wantUrl {
+				t.Errorf("parseRouterUrl() = %v, want %v", u.String(), tt.wantUrl)
+			}
+		})
+	}
+}
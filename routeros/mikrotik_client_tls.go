@@ -0,0 +1,70 @@
+package routeros
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsCipherSuites Converts cipher suite names (IANA) into IDs.
+// Insecure cipher suites are allowed for old RouterOS versions.
+func tlsCipherSuites(names []string) ([]uint16, error) {
+	var known = map[string]uint16{}
+	for _, cs := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		known[cs.Name] = cs.ID
+	}
+
+	var res []uint16
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite: %v", name)
+		}
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
+// tlsVerifyFingerprint Returns the certificate check against the SHA-256 fingerprint
+// (hex, colons are allowed) of the router certificate. The certificate chain is not verified.
+func tlsVerifyFingerprint(fingerprint string) (func([][]byte, [][]*x509.Certificate) error, error) {
+	want, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil || len(want) != sha256.Size {
+		return nil, fmt.Errorf("wrong SHA-256 fingerprint: %v", fingerprint)
+	}
+
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return fmt.Errorf("the router did not present a certificate")
+		}
+
+		got := sha256.Sum256(rawCerts[0])
+		if !bytes.Equal(got[:], want) {
+			return fmt.Errorf("the router certificate fingerprint %X does not match %X", got, want)
+		}
+
+		return nil
+	}, nil
+}
+
+// readPem Returns the PEM content of the value: it is either the PEM itself or a path to the file.
+func readPem(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
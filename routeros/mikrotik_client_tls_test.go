@@ -0,0 +1,112 @@
+package routeros
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTlsCipherSuites(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []uint16
+		wantErr bool
+	}{
+		{
+			"Secure cipher suite",
+			[]string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+			[]uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+			false,
+		},
+		{
+			"Legacy cipher suite",
+			[]string{"TLS_RSA_WITH_AES_128_CBC_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+			[]uint16{tls.TLS_RSA_WITH_AES_128_CBC_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+			false,
+		},
+		{
+			"Unknown cipher suite",
+			[]string{"TLS_FOO"},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tlsCipherSuites(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("tlsCipherSuites() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tlsCipherSuites() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTlsVerifyFingerprint(t *testing.T) {
+	cert := []byte("certificate")
+	// sha256("certificate")
+	fingerprint := "03:D6:6D:D0:88:35:C1:CA:3F:12:8C:CE:AC:D1:F3:1A:C9:41:63:09:6B:20:F4:45:AE:84:28:5B:C0:83:2D:72"
+
+	tests := []struct {
+		name        string
+		fingerprint string
+		rawCerts    [][]byte
+		wantErr     bool
+	}{
+		{"Matching fingerprint", fingerprint, [][]byte{cert}, false},
+		{"Lowercase fingerprint without colons", strings.ToLower(strings.ReplaceAll(fingerprint, ":", "")), [][]byte{cert}, false},
+		{"Other certificate", fingerprint, [][]byte{[]byte("other")}, true},
+		{"No certificate", fingerprint, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verify, err := tlsVerifyFingerprint(tt.fingerprint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = verify(tt.rawCerts, nil); (err != nil) != tt.wantErr {
+				t.Errorf("VerifyPeerCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := tlsVerifyFingerprint("AB:CD"); err == nil {
+		t.Errorf("tlsVerifyFingerprint() accepted a short fingerprint")
+	}
+}
+
+func TestReadPem(t *testing.T) {
+	pem := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+	file := filepath.Join(t.TempDir(), "ca.crt")
+	if err := os.WriteFile(file, []byte(pem), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"Inline PEM", pem, false},
+		{"File", file, false},
+		{"Missing file", filepath.Join(t.TempDir(), "missing.crt"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readPem(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readPem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != pem {
+				t.Errorf("readPem() = %q, want %q", got, pem)
+			}
+		})
+	}
+}
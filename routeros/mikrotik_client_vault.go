@@ -0,0 +1,119 @@
+package routeros
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const vaultRequestTimeout = 30 * time.Second
+
+type vaultConfig struct {
+	Address    string
+	Path       string
+	AuthMethod string
+	Token      string
+	RoleId     string
+	SecretId   string
+}
+
+type vaultResponse struct {
+	Auth *struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+	Data   json.RawMessage `json:"data"`
+	Errors []string        `json:"errors"`
+}
+
+// getVaultCredentials Reads the router credentials from the Vault KV secret (v1 or v2).
+// The secret can contain the 'hosturl', 'username' and 'password' keys.
+func getVaultCredentials(ctx context.Context, conf *vaultConfig) (*routerCredentials, error) {
+	if conf.Path == "" {
+		return nil, fmt.Errorf("vault secret path is not defined")
+	}
+
+	client := &http.Client{Timeout: vaultRequestTimeout}
+	address := strings.TrimSuffix(conf.Address, "/")
+
+	token := conf.Token
+	switch conf.AuthMethod {
+	case "token":
+		if token == "" {
+			return nil, fmt.Errorf("vault token is not defined")
+		}
+	case "approle":
+		body, _ := json.Marshal(map[string]string{"role_id": conf.RoleId, "secret_id": conf.SecretId})
+
+		res, err := vaultRequest(ctx, client, http.MethodPost, address+"/v1/auth/approle/login", "", body)
+		if err != nil {
+			return nil, err
+		}
+		if res.Auth == nil || res.Auth.ClientToken == "" {
+			return nil, fmt.Errorf("vault AppRole login returned no token")
+		}
+		token = res.Auth.ClientToken
+	default:
+		return nil, fmt.Errorf("unsupported vault auth method: %v", conf.AuthMethod)
+	}
+
+	res, err := vaultRequest(ctx, client, http.MethodGet, address+"/v1/"+strings.TrimPrefix(conf.Path, "/"), token, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// KV v2: {"data": {"data": {...}, "metadata": {...}}}
+	var kv2 struct {
+		Data     *routerCredentials `json:"data"`
+		Metadata json.RawMessage    `json:"metadata"`
+	}
+	if err = json.Unmarshal(res.Data, &kv2); err == nil && kv2.Data != nil && kv2.Metadata != nil {
+		return kv2.Data, nil
+	}
+
+	// KV v1: {"data": {...}}
+	var creds routerCredentials
+	if err = json.Unmarshal(res.Data, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse the vault secret: %v", err)
+	}
+
+	return &creds, nil
+}
+
+func vaultRequest(ctx context.Context, client *http.Client, method, url, token string, body []byte) (*vaultResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	ColorizedDebug(ctx, method+" vault URL: "+url)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res vaultResponse
+	if err = json.Unmarshal(b, &res); err != nil && resp.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("failed to parse the vault response: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault returned response code: %v, errors: %v", resp.StatusCode, res.Errors)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetVaultCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/auth/approle/login":
+			_, _ = w.Write([]byte(`{"auth": {"client_token": "approle-token"}}`))
+		case r.Header.Get("X-Vault-Token") != "s.token" && r.Header.Get("X-Vault-Token") != "approle-token":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+		case r.URL.Path == "/v1/secret/data/router":
+			_, _ = w.Write([]byte(`{"data": {"data": {"username": "admin", "password": "secret"}, "metadata": {"version": 1}}}`))
+		case r.URL.Path == "/v1/kv/router":
+			_, _ = w.Write([]byte(`{"data": {"hosturl": "apis://router.local", "username": "admin", "password": "secret"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors": []}`))
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		conf    *vaultConfig
+		want    *routerCredentials
+		wantErr bool
+	}{
+		{
+			"KV v2 with token",
+			&vaultConfig{Address: srv.URL, Path: "secret/data/router", AuthMethod: "token", Token: "s.token"},
+			&routerCredentials{Username: "admin", Password: "secret"},
+			false,
+		},
+		{
+			"KV v1 with AppRole",
+			&vaultConfig{Address: srv.URL, Path: "kv/router", AuthMethod: "approle", RoleId: "role", SecretId: "secret"},
+			&routerCredentials{HostURL: "apis://router.local", Username: "admin", Password: "secret"},
+			false,
+		},
+		{
+			"Wrong token",
+			&vaultConfig{Address: srv.URL, Path: "kv/router", AuthMethod: "token", Token: "wrong"},
+			nil,
+			true,
+		},
+		{
+			"Secret not found",
+			&vaultConfig{Address: srv.URL, Path: "kv/missing", AuthMethod: "token", Token: "s.token"},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getVaultCredentials(context.Background(), tt.conf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getVaultCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getVaultCredentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package routeros
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 )
 
 // resource path is '/interface/vlan' etc.
@@ -39,7 +41,7 @@ func CreateItem(ctx context.Context, item MikrotikItem, resourcePath string, c C
 	return res, err
 }
 
-func ReadItems(id *ItemId, resourcePath string, c Client) (*[]MikrotikItem, error) {
+func ReadItems(id *ItemId, resourcePath string, c Client, proplist ...string) (*[]MikrotikItem, error) {
 	// id can be empty.
 
 	if resourcePath == "" {
@@ -56,15 +58,14 @@ func ReadItems(id *ItemId, resourcePath string, c Client) (*[]MikrotikItem, erro
 
 		// /interface/vlan?.id=*39
 		url.Query = []string{"?" + id.Type.String() + "=" + id.Value}
-	}
 
-	var res []MikrotikItem
-	err := c.SendRequest(crudRead, url, nil, &res)
+		return readItems(url, c, proplist)
+	}
 
-	return &res, err
+	return readItemsPaged(url, c, proplist)
 }
 
-func ReadItemsFiltered(filter []string, resourcePath string, c Client) (*[]MikrotikItem, error) {
+func ReadItemsFiltered(filter []string, resourcePath string, c Client, proplist ...string) (*[]MikrotikItem, error) {
 	if resourcePath == "" {
 		return nil, errEmptyPath
 	}
@@ -72,19 +73,85 @@ func ReadItemsFiltered(filter []string, resourcePath string, c Client) (*[]Mikro
 	// Filter format: name=value
 	// REST query: name=value; name=value
 	// API  query: ?=name=value; ?=name=value
-	if c.GetTransport() == TransportAPI {
+	if c.GetTransport() != TransportREST {
 		for i, s := range filter {
 			filter[i] = "?=" + s
 		}
 	}
 	url := &URL{Path: resourcePath, Query: filter}
 
+	return readItemsPaged(url, c, proplist)
+}
+
+func readItems(url *URL, c Client, proplist []string) (*[]MikrotikItem, error) {
+	if len(proplist) > 0 {
+		url.Query = append(url.Query, proplistQuery(c, proplist))
+	}
+
 	var res []MikrotikItem
 	err := c.SendRequest(crudRead, url, nil, &res)
 
 	return &res, err
 }
 
+// readItemsPaged Reads large REST tables in pages: the REST server can time out or truncate the response
+// of tens of thousands of items. The item IDs are read first, then the items are requested by the IDs.
+func readItemsPaged(url *URL, c Client, proplist []string) (*[]MikrotikItem, error) {
+	var pageSize int
+	if extra := c.GetExtraParams(); extra != nil {
+		pageSize = extra.PageSize
+	}
+
+	if pageSize <= 0 || c.GetTransport() != TransportREST {
+		return readItems(url, c, proplist)
+	}
+
+	ids, err := readItems(&URL{Path: url.Path, Query: slices.Clone(url.Query)}, c, []string{".id"})
+	if err != nil {
+		return ids, err
+	}
+
+	// Small tables and the menus without IDs are read at once.
+	if len(*ids) <= pageSize || slices.ContainsFunc(*ids, func(item MikrotikItem) bool { return item[".id"] == "" }) {
+		return readItems(url, c, proplist)
+	}
+
+	var item MikrotikItem
+	if len(proplist) > 0 {
+		item = MikrotikItem{".proplist": strings.Join(proplist, ",")}
+	}
+
+	res := make([]MikrotikItem, 0, len(*ids))
+	for page := range slices.Chunk(*ids, pageSize) {
+		// .id=*1 .id=*2 #| .id=*3 #|
+		query := make([]string, 0, 2*len(page))
+		for i, id := range page {
+			query = append(query, ".id="+id[".id"])
+			if i > 0 {
+				query = append(query, "#|")
+			}
+		}
+
+		var items []MikrotikItem
+		if err = c.SendRequest(crudPrint, &URL{Path: url.Path, Query: query}, item, &items); err != nil {
+			return &res, err
+		}
+		res = append(res, items...)
+	}
+
+	return &res, nil
+}
+
+// proplistQuery Returns the query of the attributes to be read.
+// REST query: .proplist=name1,name2
+// API  query: =.proplist=name1,name2
+func proplistQuery(c Client, proplist []string) string {
+	if c.GetTransport() == TransportREST {
+		return ".proplist=" + strings.Join(proplist, ",")
+	}
+	return "=.proplist=" + strings.Join(proplist, ",")
+}
+
 func UpdateItem(id *ItemId, resourcePath string, item MikrotikItem, c Client) (MikrotikItem, error) {
 	if id.Value == "" {
 		return nil, errEmptyId
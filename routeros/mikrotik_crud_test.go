@@ -0,0 +1,85 @@
+package routeros
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReadItemsFiltered_Paged(t *testing.T) {
+	var ids []string
+	for i := 1; i <= 5; i++ {
+		ids = append(ids, "*"+strconv.Itoa(i))
+	}
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res []MikrotikItem
+
+		switch {
+		case r.Method == "GET" && r.URL.Query().Get(".proplist") == ".id":
+			requests = append(requests, "GET "+r.URL.RawQuery)
+			for _, id := range ids {
+				res = append(res, MikrotikItem{".id": id})
+			}
+		case r.Method == "POST" && r.URL.Path == "/rest/ip/firewall/address-list/print":
+			var body struct {
+				Query    []string `json:".query"`
+				Proplist string   `json:".proplist"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			requests = append(requests, "POST "+strings.Join(body.Query, " ")+" proplist="+body.Proplist)
+			for _, q := range body.Query {
+				if id, ok := strings.CutPrefix(q, ".id="); ok {
+					res = append(res, MikrotikItem{".id": id, "address": "10.0.0." + id[1:]})
+				}
+			}
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer srv.Close()
+
+	c := &RestClient{
+		ctx:       context.Background(),
+		HostURL:   srv.URL,
+		Transport: TransportREST,
+		extra:     &ExtraParams{PageSize: 2},
+		Client:    srv.Client(),
+	}
+
+	res, err := ReadItemsFiltered([]string{"list=blocked"}, "/ip/firewall/address-list", c, ".id", "address")
+	if err != nil {
+		t.Fatalf("ReadItemsFiltered() error = %v", err)
+	}
+
+	var got []string
+	for _, item := range *res {
+		got = append(got, item[".id"])
+	}
+	if !slices.Equal(got, ids) {
+		t.Errorf("ReadItemsFiltered() items = %v, want %v", got, ids)
+	}
+
+	want := []string{
+		"GET list=blocked&.proplist=.id",
+		"POST .id=*1 .id=*2 #| proplist=.id,address",
+		"POST .id=*3 .id=*4 #| proplist=.id,address",
+		"POST .id=*5 proplist=.id,address",
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("ReadItemsFiltered() requests = %q, want %q", requests, want)
+	}
+}
@@ -0,0 +1,229 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The number of the items that are added or removed by one request.
+const itemsBatchSize = 100
+
+// listMembersSetRead Returns the read of the resource that owns all members of the list: the 'key' field of the
+// members is stored in the 'attr' set. The router value is replaced by the configured one if they are the same
+// after the normalization: '10.0.0.1/32' and '10.0.0.1'.
+func listMembersSetRead(path, key, attr string, normalize func(string) string) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		items, err := readListMembers(path, d.Id(), key, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return diag.FromErr(err)
+		}
+
+		configured := map[string]string{}
+		for _, v := range d.Get(attr).(*schema.Set).List() {
+			configured[normalizeListMember(normalize, v.(string))] = v.(string)
+		}
+
+		values := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			value := item[key]
+			if v, ok := configured[normalizeListMember(normalize, value)]; ok {
+				value = v
+			}
+			values = append(values, value)
+		}
+
+		if err = d.Set("list", d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set(attr, values); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+// listMembersSetCreateUpdate Returns the create and update of the resource that owns all members of the list.
+func listMembersSetCreateUpdate(path, key, attr string,
+	normalize func(string) string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+
+	read := listMembersSetRead(path, key, attr, normalize)
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		list := d.Get("list").(string)
+
+		var want []string
+		for _, v := range d.Get(attr).(*schema.Set).List() {
+			want = append(want, v.(string))
+		}
+
+		if err := syncListMembers(ctx, path, list, key, normalize, want, m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId(list)
+		return read(ctx, d, m)
+	}
+}
+
+// listMembersSetDelete Returns the delete of the resource that owns all members of the list.
+func listMembersSetDelete(path, key string, normalize func(string) string) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := syncListMembers(ctx, path, d.Id(), key, normalize, nil, m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// normalizeListMember Returns the value in the router notation, the values are compared as is if there is no
+// normalization.
+func normalizeListMember(normalize func(string) string, value string) string {
+	if normalize == nil {
+		return value
+	}
+	return normalize(value)
+}
+
+// readListMembers Reads the static members of the list.
+func readListMembers(path, list, key string, c Client) ([]MikrotikItem, error) {
+	items, err := ReadItemsFiltered([]string{"list=" + list}, path, c, ".id", key, "dynamic")
+	if err != nil {
+		return nil, err
+	}
+
+	var res []MikrotikItem
+	for _, item := range *items {
+		if item["dynamic"] == "true" {
+			continue
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// syncListMembers Removes the members that are not wanted and adds the missing members in batches. The members
+// are compared by the normalized 'key' field, the duplicates are removed.
+func syncListMembers(ctx context.Context, path, list, key string, normalize func(string) string, want []string,
+	c Client) error {
+
+	existing, err := readListMembers(path, list, key, c)
+	if err != nil {
+		return err
+	}
+
+	present := map[string]bool{}
+	for _, value := range want {
+		present[normalizeListMember(normalize, value)] = false
+	}
+
+	var remove []string
+	for _, item := range existing {
+		value := normalizeListMember(normalize, item[key])
+		if added, ok := present[value]; ok && !added {
+			present[value] = true
+			continue
+		}
+		remove = append(remove, item.GetID(Id))
+	}
+
+	if err = removeItems(path, remove, c); err != nil {
+		return err
+	}
+
+	var add []MikrotikItem
+	for _, value := range want {
+		if present[normalizeListMember(normalize, value)] {
+			continue
+		}
+		add = append(add, MikrotikItem{"list": list, key: value})
+		present[normalizeListMember(normalize, value)] = true
+	}
+	if len(add) == 0 {
+		return nil
+	}
+
+	if err = addItems(path, add, c); err != nil {
+		return err
+	}
+
+	// The script skips the members that the router rejects, they are added one by one to return the error.
+	if existing, err = readListMembers(path, list, key, c); err != nil {
+		return err
+	}
+
+	added := map[string]bool{}
+	for _, item := range existing {
+		added[normalizeListMember(normalize, item[key])] = true
+	}
+
+	for _, item := range add {
+		if added[normalizeListMember(normalize, item[key])] {
+			continue
+		}
+
+		if _, err = CreateItem(ctx, item, path, c); err != nil {
+			return fmt.Errorf("%v '%v': %w", key, item[key], err)
+		}
+	}
+
+	return nil
+}
+
+// addItems Adds the items by one script per batch. The script is executed synchronously ('as-string') and
+// skips the items that cannot be added.
+func addItems(path string, items []MikrotikItem, c Client) error {
+	for batch := range slices.Chunk(items, itemsBatchSize) {
+		var script []string
+		for _, item := range batch {
+			script = append(script, ":do { "+addItemCommand(path, item)+" } on-error={}")
+		}
+
+		item := MikrotikItem{"script": strings.Join(script, "\n"), "as-string": ""}
+		if err := c.SendRequest(crudRunScript, &URL{Path: "/execute"}, item, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addItemCommand Returns the CLI command that adds the item: /ip/firewall/address-list/add address="10.0.0.1" list="l"
+func addItemCommand(path string, item MikrotikItem) string {
+	keys := make([]string, 0, len(item))
+	for k := range item {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	cmd := path + "/add"
+	for _, k := range keys {
+		cmd += " " + k + "=\"" + sshEscaper.Replace(item[k]) + "\""
+	}
+	return cmd
+}
+
+// removeItems Removes the items by one request per batch.
+func removeItems(path string, ids []string, c Client) error {
+	if c.GetTransport() == TransportREST {
+		path += "/remove"
+	}
+
+	for batch := range slices.Chunk(ids, itemsBatchSize) {
+		item := MikrotikItem{"numbers": strings.Join(batch, ",")}
+		if err := c.SendRequest(crudRemove, &URL{Path: path}, item, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestListMembersSetRead(t *testing.T) {
+	r := ResourceIPFirewallAddrListSet()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"list":      "tf-test",
+		"addresses": []interface{}{"10.0.0.1/32", "192.168.0.0-192.168.1.255"},
+	})
+	d.SetId("tf-test")
+
+	c := &testAddrListClient{items: []MikrotikItem{
+		{".id": "*1", "address": "10.0.0.1"},
+		{".id": "*2", "address": "192.168.0.0/23"},
+		{".id": "*3", "address": "10.0.1.0/24"},
+		{".id": "*4", "address": "10.0.9.9", "dynamic": "true"},
+	}}
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var got []string
+	for _, v := range d.Get("addresses").(*schema.Set).List() {
+		got = append(got, v.(string))
+	}
+	sort.Strings(got)
+
+	// The router values are replaced by the configured ones, the unknown entries are added as is.
+	want := []string{"10.0.0.1/32", "10.0.1.0/24", "192.168.0.0-192.168.1.255"}
+	if !reflect.DeepEqual(got, want) || d.Get("list") != "tf-test" {
+		t.Errorf("list = %v, addresses = %v, want %v", d.Get("list"), got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package routeros
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The marker in the comment of the objects created by the provider: "<marker> <comment>".
+// It is added on create/update and removed on read, so it does not appear in the state.
+
+func managedComment(m interface{}) string {
+	if c, ok := m.(Client); ok && c.GetExtraParams() != nil {
+		return c.GetExtraParams().ManagedComment
+	}
+	return ""
+}
+
+// addManagedComment Prepends the marker to the comment if the resource has the comment attribute.
+func addManagedComment(item MikrotikItem, s map[string]*schema.Schema, m interface{}) {
+	marker := managedComment(m)
+	if _, ok := s[KeyComment]; !ok || marker == "" {
+		return
+	}
+
+	if comment := item[KeyComment]; comment != "" {
+		item[KeyComment] = marker + " " + comment
+	} else {
+		item[KeyComment] = marker
+	}
+}
+
+// stripManagedComment Removes the marker from the comment read from the router.
+func stripManagedComment(item MikrotikItem, m interface{}) {
+	marker := managedComment(m)
+	if marker == "" {
+		return
+	}
+
+	comment, ok := item[KeyComment]
+	if !ok {
+		return
+	}
+
+	if comment == marker {
+		item[KeyComment] = ""
+	} else if strings.HasPrefix(comment, marker+" ") {
+		item[KeyComment] = strings.TrimPrefix(comment, marker+" ")
+	}
+}
@@ -0,0 +1,44 @@
+package routeros
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestManagedComment(t *testing.T) {
+	withComment := map[string]*schema.Schema{KeyComment: PropCommentRw}
+	m := &testOperationClient{extra: &ExtraParams{ManagedComment: "[tf]"}}
+
+	tests := []struct {
+		name     string
+		s        map[string]*schema.Schema
+		m        interface{}
+		item     MikrotikItem
+		wantSent MikrotikItem
+	}{
+		{"Disabled", withComment, &testOperationClient{extra: &ExtraParams{}}, MikrotikItem{"comment": "ssh"}, MikrotikItem{"comment": "ssh"}},
+		{"Comment", withComment, m, MikrotikItem{"comment": "ssh"}, MikrotikItem{"comment": "[tf] ssh"}},
+		{"No comment", withComment, m, MikrotikItem{"name": "vlan10"}, MikrotikItem{"name": "vlan10", "comment": "[tf]"}},
+		{"No comment attribute", map[string]*schema.Schema{}, m, MikrotikItem{"name": "vlan10"}, MikrotikItem{"name": "vlan10"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := MikrotikItem{}
+			for k, v := range tt.item {
+				item[k] = v
+			}
+
+			addManagedComment(item, tt.s, tt.m)
+			if !reflect.DeepEqual(item, tt.wantSent) {
+				t.Errorf("addManagedComment() = %v, want %v", item, tt.wantSent)
+			}
+
+			stripManagedComment(item, tt.m)
+			if item[KeyComment] != tt.item[KeyComment] {
+				t.Errorf("stripManagedComment() = %q, want %q", item[KeyComment], tt.item[KeyComment])
+			}
+		})
+	}
+}
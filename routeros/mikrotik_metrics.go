@@ -0,0 +1,202 @@
+package routeros
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Provider metrics in the Prometheus text format: the number of requests per path, request latencies,
+// retries and the duration of the resource operations. The file is written when the plugin server stops,
+// it can be collected by the node exporter textfile collector or inspected after the apply.
+
+// Upper bounds of the latency histogram buckets in seconds.
+var metricsBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+var (
+	metricsMu    sync.Mutex
+	metricsFiles = map[string]*Metrics{}
+)
+
+type Metrics struct {
+	mu         sync.Mutex
+	path       string
+	requests   map[requestMetricKey]*metricsHistogram
+	errors     map[requestMetricKey]int
+	retries    map[requestMetricKey]int
+	operations map[operationMetricKey]*metricsHistogram
+}
+
+type requestMetricKey struct {
+	transport, method, path string
+}
+
+type operationMetricKey struct {
+	resource, operation string
+}
+
+type metricsHistogram struct {
+	buckets []int
+	count   int
+	sum     float64
+}
+
+// NewMetrics Returns the metrics written to the file. Provider configurations with the same file
+// share the metrics.
+func NewMetrics(path string) *Metrics {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	if m, ok := metricsFiles[path]; ok {
+		return m
+	}
+
+	m := &Metrics{
+		path:       path,
+		requests:   map[requestMetricKey]*metricsHistogram{},
+		errors:     map[requestMetricKey]int{},
+		retries:    map[requestMetricKey]int{},
+		operations: map[operationMetricKey]*metricsHistogram{},
+	}
+	metricsFiles[path] = m
+
+	return m
+}
+
+// WriteMetrics Writes the metrics files. It is called when the plugin server stops.
+func WriteMetrics() {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	for _, m := range metricsFiles {
+		_ = m.Write()
+	}
+}
+
+// ObserveRequest Records the request latency, the number of attempts and the result.
+func (m *Metrics) ObserveRequest(transport TransportType, method crudMethod, url *URL, d time.Duration,
+	attempts int, err error) {
+
+	key := requestMetricKey{
+		transport: transport.String(),
+		method:    strings.TrimPrefix(apiMethodName[method], "/"),
+		path:      url.Path,
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	observe(m.requests, key, d)
+	if attempts > 1 {
+		m.retries[key] += attempts - 1
+	}
+	if err != nil {
+		m.errors[key]++
+	}
+}
+
+// ObserveOperation Records the duration of the Terraform operation on a resource or data source.
+func (m *Metrics) ObserveOperation(resource, operation string, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	observe(m.operations, operationMetricKey{resource: resource, operation: operation}, d)
+}
+
+func observe[K comparable](histograms map[K]*metricsHistogram, key K, d time.Duration) {
+	h, ok := histograms[key]
+	if !ok {
+		h = &metricsHistogram{buckets: make([]int, len(metricsBuckets))}
+		histograms[key] = h
+	}
+
+	seconds := d.Seconds()
+	for i, le := range metricsBuckets {
+		if seconds <= le {
+			h.buckets[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// Write Replaces the metrics file.
+func (m *Metrics) Write() error {
+	m.mu.Lock()
+	data := m.format()
+	m.mu.Unlock()
+
+	tmp := filepath.Join(filepath.Dir(m.path), "."+filepath.Base(m.path)+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, m.path)
+}
+
+func (m *Metrics) format() []byte {
+	var b bytes.Buffer
+
+	requests := slices.SortedFunc(maps.Keys(m.requests), compareRequestKeys)
+	requestLabels := func(k requestMetricKey) string {
+		return fmt.Sprintf(`transport=%q,method=%q,path=%q`, k.transport, k.method, k.path)
+	}
+
+	writeHelp(&b, "routeros_requests_total", "counter", "Number of requests sent to the router.")
+	for _, k := range requests {
+		fmt.Fprintf(&b, "routeros_requests_total{%s} %d\n", requestLabels(k), m.requests[k].count)
+	}
+
+	writeHelp(&b, "routeros_request_errors_total", "counter", "Number of failed requests.")
+	for _, k := range requests {
+		fmt.Fprintf(&b, "routeros_request_errors_total{%s} %d\n", requestLabels(k), m.errors[k])
+	}
+
+	writeHelp(&b, "routeros_request_retries_total", "counter", "Number of request retries after transient errors.")
+	for _, k := range requests {
+		fmt.Fprintf(&b, "routeros_request_retries_total{%s} %d\n", requestLabels(k), m.retries[k])
+	}
+
+	writeHelp(&b, "routeros_request_duration_seconds", "histogram", "Latency of the requests including retries.")
+	for _, k := range requests {
+		writeHistogram(&b, "routeros_request_duration_seconds", requestLabels(k), m.requests[k])
+	}
+
+	operations := slices.SortedFunc(maps.Keys(m.operations), func(a, b operationMetricKey) int {
+		return cmp.Or(cmp.Compare(a.resource, b.resource), cmp.Compare(a.operation, b.operation))
+	})
+
+	writeHelp(&b, "routeros_operation_duration_seconds", "histogram",
+		"Duration of the Terraform operations on resources and data sources.")
+	for _, k := range operations {
+		labels := fmt.Sprintf(`resource=%q,operation=%q`, k.resource, k.operation)
+		writeHistogram(&b, "routeros_operation_duration_seconds", labels, m.operations[k])
+	}
+
+	return b.Bytes()
+}
+
+func compareRequestKeys(a, b requestMetricKey) int {
+	return cmp.Or(cmp.Compare(a.path, b.path), cmp.Compare(a.method, b.method), cmp.Compare(a.transport, b.transport))
+}
+
+func writeHelp(b *bytes.Buffer, name, typ, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func writeHistogram(b *bytes.Buffer, name, labels string, h *metricsHistogram) {
+	for i, le := range metricsBuckets {
+		fmt.Fprintf(b, "%s_bucket{%s,le=%q} %d\n", name, labels, strconv.FormatFloat(le, 'g', -1, 64), h.buckets[i])
+	}
+	fmt.Fprintf(b, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, labels, h.count)
+	fmt.Fprintf(b, "%s_sum{%s} %s\n", name, labels, strconv.FormatFloat(h.sum, 'f', -1, 64))
+	fmt.Fprintf(b, "%s_count{%s} %d\n", name, labels, h.count)
+}
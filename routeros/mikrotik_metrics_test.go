@@ -0,0 +1,48 @@
+package routeros
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetrics_Write(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "routeros.prom")
+	m := NewMetrics(path)
+	if NewMetrics(path) != m {
+		t.Errorf("NewMetrics() returned a new instance for the same file")
+	}
+
+	url := &URL{Path: "/ip/address"}
+	m.ObserveRequest(TransportREST, crudRead, url, 30*time.Millisecond, 1, nil)
+	m.ObserveRequest(TransportREST, crudRead, url, 2*time.Second, 3, errors.New("timed out"))
+	m.ObserveOperation("routeros_ip_address", "read", 3*time.Second)
+
+	if err := m.Write(); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labels := `transport="rest",method="print",path="/ip/address"`
+	for _, want := range []string{
+		"# TYPE routeros_requests_total counter\n",
+		"routeros_requests_total{" + labels + "} 2\n",
+		"routeros_request_errors_total{" + labels + "} 1\n",
+		"routeros_request_retries_total{" + labels + "} 2\n",
+		"routeros_request_duration_seconds_bucket{" + labels + `,le="0.05"} 1` + "\n",
+		"routeros_request_duration_seconds_bucket{" + labels + `,le="2.5"} 2` + "\n",
+		"routeros_request_duration_seconds_sum{" + labels + "} 2.03\n",
+		`routeros_operation_duration_seconds_count{resource="routeros_ip_address",operation="read"} 1` + "\n",
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("metrics file does not contain %q:\n%s", want, b)
+		}
+	}
+}
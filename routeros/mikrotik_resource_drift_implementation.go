@@ -67,7 +67,12 @@ func (do *driftObjects) index(version uint64) int {
 
 // Obtaining a map to match TF attributes and MT parameters for further transformation.
 // Direct output (for TF to MT serialization) and reverse output (MT to TF) are provided.
+// The version is unknown if its detection is disabled, no transformation is done in this case.
 func (do *driftObjects) GetDriftMap(ros, resName string, reverse bool) (res map[string]string) {
+	if ros == "" {
+		return nil
+	}
+
 	version, err := parseRouterOSVersion(ros)
 	if err != nil {
 		log.Fatal(err)
@@ -29,6 +29,8 @@ func GetMetadata(s map[string]*schema.Schema) *MikrotikItemMetadata {
 				meta.IdType = IdType(terraformMetadata.Default.(int))
 			case MetaResourcePath:
 				meta.Path = terraformMetadata.Default.(string)
+			case MetaTransport:
+				// The resource configuration, it is not the metadata of the item.
 			default:
 				if meta.Meta == nil {
 					meta.Meta = make(map[string]string)
@@ -114,7 +116,18 @@ func ListToString(v any) (res string) {
 }
 
 // TerraformResourceDataToMikrotik Marshal Mikrotik resource from TF resource schema.
-func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.ResourceData) (MikrotikItem, *MikrotikItemMetadata) {
+func TerraformResourceDataToMikrotik(ros string, s map[string]*schema.Schema, d *schema.ResourceData) (MikrotikItem, *MikrotikItemMetadata) {
+	return terraformResourceDataToMikrotik(ros, s, d, false)
+}
+
+// TerraformResourceDataChangesToMikrotik Marshal only the changed attributes of TF resource, so that the update
+// does not re-apply the unchanged properties. The write-only attributes are sent when their version changes.
+func TerraformResourceDataChangesToMikrotik(ros string, s map[string]*schema.Schema, d *schema.ResourceData) (MikrotikItem, *MikrotikItemMetadata) {
+	return terraformResourceDataToMikrotik(ros, s, d, true)
+}
+
+func terraformResourceDataToMikrotik(ros string, s map[string]*schema.Schema, d *schema.ResourceData,
+	changesOnly bool) (MikrotikItem, *MikrotikItemMetadata) {
 	item := MikrotikItem{}
 	meta := &MikrotikItemMetadata{}
 	rawConfig := d.GetRawConfig()
@@ -127,7 +140,7 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 	}
 
 	// Resource attribute drift compensation.
-	if drift := driftAttributeSlice.GetDriftMap(RouterOSVersion, s[MetaResourcePath].Default.(string), false); len(drift) > 0 {
+	if drift := driftAttributeSlice.GetDriftMap(ros, s[MetaResourcePath].Default.(string), false); len(drift) > 0 {
 		if transformSet == nil {
 			transformSet = make(map[string]string)
 		}
@@ -143,6 +156,7 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 	if suf, ok := s[MetaSetUnsetFields]; ok {
 		setUnsetFields = loadSkipFields(suf.Default.(string))
 	}
+	ignoredFields := loadIgnoredServerFields(s, d)
 
 	// Schema map iteration.
 	for terraformSnakeName, terraformMetadata := range s {
@@ -153,7 +167,8 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 				meta.IdType = IdType(terraformMetadata.Default.(int))
 			case MetaResourcePath:
 				meta.Path = terraformMetadata.Default.(string)
-			case MetaTransformSet, MetaSkipFields, MetaSetUnsetFields, MetaDropByValue:
+			case MetaTransformSet, MetaSkipFields, MetaSetUnsetFields, MetaDropByValue, MetaTransport,
+				MetaMinVersion:
 				continue
 			default:
 				meta.Meta[terraformSnakeName] = terraformMetadata.Default.(string)
@@ -171,6 +186,43 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 			continue
 		}
 
+		if changesOnly {
+			name := terraformSnakeName
+			if terraformMetadata.WriteOnly {
+				name = strings.TrimSuffix(name, writeOnlySuffix) + writeOnlyVersionSuffix
+			}
+			if !d.HasChange(name) {
+				continue
+			}
+		}
+
+		// password_wo => password, the value of the write-only field is only available in the configuration.
+		if terraformMetadata.WriteOnly {
+			if v := rawConfig.GetAttr(terraformSnakeName); v.IsKnown() && !v.IsNull() {
+				name := strings.TrimSuffix(terraformSnakeName, writeOnlySuffix)
+				if new, ok := transformSet[name]; ok {
+					name = new
+				}
+				item[SnakeToKebab(name)] = v.AsString()
+			}
+			continue
+		}
+
+		if strings.HasSuffix(terraformSnakeName, writeOnlyVersionSuffix) {
+			continue
+		}
+
+		// The provider options of the resource.
+		switch terraformSnakeName {
+		case KeyAdoptExisting, KeyDestroyBehavior, KeyIgnoreChangesServer:
+			continue
+		}
+
+		// The fields that are managed on the router.
+		if _, ok := ignoredFields[terraformSnakeName]; ok {
+			continue
+		}
+
 		/*
 			Skip all empty Optional fields.
 			This logic may be broken, but I don't have enough examples to test it.
@@ -331,7 +383,7 @@ func TerraformResourceDataToMikrotik(s map[string]*schema.Schema, d *schema.Reso
 }
 
 // MikrotikResourceDataToTerraform Unmarshal Mikrotik resource (incoming data: JSON, etc.) to TF resource schema.
-func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Schema, d *schema.ResourceData) diag.Diagnostics {
+func MikrotikResourceDataToTerraform(ros string, item MikrotikItem, s map[string]*schema.Schema, d *schema.ResourceData) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var err error
 	var transformSet map[string]string
@@ -343,7 +395,7 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 	}
 
 	// Resource attribute drift compensation.
-	if drift := driftAttributeSlice.GetDriftMap(RouterOSVersion, s[MetaResourcePath].Default.(string), true); len(drift) > 0 {
+	if drift := driftAttributeSlice.GetDriftMap(ros, s[MetaResourcePath].Default.(string), true); len(drift) > 0 {
 		if transformSet == nil {
 			transformSet = make(map[string]string)
 		}
@@ -404,6 +456,10 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 			}
 		}
 
+		if isWriteOnlyValue(terraformSnakeName, s, d) {
+			continue
+		}
+
 		// Composite fields.
 		var subFieldSnakeName string
 		if strings.Contains(terraformSnakeName, ".") {
@@ -589,7 +645,38 @@ func MikrotikResourceDataToTerraform(item MikrotikItem, s map[string]*schema.Sch
 	return diags
 }
 
-func MikrotikResourceDataToTerraformDatasource(items *[]MikrotikItem, resourceDataKeyName string, s map[string]*schema.Schema, d *schema.ResourceData) diag.Diagnostics {
+// datasourceProplist Returns the MikroTik names of the datasource attributes to limit the read (.proplist).
+// The list is empty if the names can not be derived from the schema (transformed attributes).
+func datasourceProplist(ros string, s map[string]*schema.Schema, resourceDataKeyName string) []string {
+	sv, ok := s[resourceDataKeyName]
+	if !ok {
+		return nil
+	}
+	if _, ok := s[MetaTransformSet]; ok {
+		return nil
+	}
+
+	// {"tf_field_name": "mikrotik-field-name"}
+	drift := driftAttributeSlice.GetDriftMap(ros, s[MetaResourcePath].Default.(string), false)
+
+	var res []string
+	for name := range sv.Elem.(*schema.Resource).Schema {
+		if name == "id" {
+			res = append(res, ".id")
+			continue
+		}
+
+		if mt, ok := drift[name]; ok {
+			res = append(res, mt)
+		}
+		res = append(res, SnakeToKebab(name))
+	}
+	slices.Sort(res)
+
+	return res
+}
+
+func MikrotikResourceDataToTerraformDatasource(ros string, items *[]MikrotikItem, resourceDataKeyName string, s map[string]*schema.Schema, d *schema.ResourceData) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var dsItems []map[string]interface{}
 	// System resource have an empty 'resourceDataKeyName'.
@@ -597,8 +684,13 @@ func MikrotikResourceDataToTerraformDatasource(items *[]MikrotikItem, resourceDa
 	var transformSet map[string]string
 	var skipFields map[string]struct{}
 
+	// {"remote-address": "remote.address", "tf-field-name": "mikrotik-field-name"}
+	if ts, ok := s[MetaTransformSet]; ok {
+		transformSet = loadTransformSet(ts.Default.(string), true)
+	}
+
 	// Resource attribute drift compensation.
-	if drift := driftAttributeSlice.GetDriftMap(RouterOSVersion, s[MetaResourcePath].Default.(string), true); len(drift) > 0 {
+	if drift := driftAttributeSlice.GetDriftMap(ros, s[MetaResourcePath].Default.(string), true); len(drift) > 0 {
 		if transformSet == nil {
 			transformSet = make(map[string]string)
 		}
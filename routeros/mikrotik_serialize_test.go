@@ -2,6 +2,7 @@ package routeros
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,7 +67,7 @@ func Test_mikrotikResourceDataToTerraform(t *testing.T) {
 	testResourceData := testResource.TestResourceData()
 	expectedRes := map[string]interface{}{"string": "string12345", "float": 0.01, "int": 10, "bool": true}
 
-	err := MikrotikResourceDataToTerraform(testItem, testResource.Schema, testResourceData)
+	err := MikrotikResourceDataToTerraform("7.19", testItem, testResource.Schema, testResourceData)
 	if err != nil {
 		t.Errorf("decoding err: %v", err)
 	}
@@ -92,7 +93,7 @@ func Test_terraformResourceDataToMikrotik(t *testing.T) {
 	testResourceData.Set("int", 10)
 	testResourceData.Set("bool", true)
 
-	actual, _ := TerraformResourceDataToMikrotik(testResource.Schema, testResourceData)
+	actual, _ := TerraformResourceDataToMikrotik("7.19", testResource.Schema, testResourceData)
 
 	if !reflect.DeepEqual(actual, expected) {
 		t.Fatalf("bad: expected:%#v\nactual:%#v", expected, actual)
@@ -111,7 +112,7 @@ func Test_mikrotikResourceDataToTerraformDatasource(t *testing.T) {
 		{MetaResourcePath: "", MetaId: 0, "string": "12345string", "float": 0.02, "int": 20, "bool": false},
 	}
 
-	err := MikrotikResourceDataToTerraformDatasource(&testItems, "test_name", testDatasource.Schema, testResourceData)
+	err := MikrotikResourceDataToTerraformDatasource("7.19", &testItems, "test_name", testDatasource.Schema, testResourceData)
 	if err != nil {
 		t.Errorf("decoding err: %v", err)
 	}
@@ -170,3 +171,18 @@ func Test_loadSkipFields(t *testing.T) {
 		}
 	}
 }
+
+func Test_datasourceProplist(t *testing.T) {
+	s := DatasourceIPRoutes().Schema
+
+	got := datasourceProplist("7.19", s, "routes")
+	for _, name := range []string{".id", "dst-address", "gateway"} {
+		if !slices.Contains(got, name) {
+			t.Errorf("datasourceProplist() = %v, the attribute '%v' is missing", got, name)
+		}
+	}
+
+	if got := datasourceProplist("7.19", s, "data"); got != nil {
+		t.Errorf("datasourceProplist() = %v, the unknown field must return an empty list", got)
+	}
+}
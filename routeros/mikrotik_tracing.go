@@ -0,0 +1,136 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OpenTelemetry tracing of provider operations.
+// Spans are exported with OTLP if one of the standard variables is set:
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT.
+// The exporter is configured by the other OTEL_EXPORTER_OTLP_* variables: the protocol ('http/protobuf' or
+// 'grpc'), headers, timeout, compression and certificates.
+
+const tracerName = "github.com/terraform-routeros/terraform-provider-routeros"
+
+var (
+	tracer         = otel.Tracer(tracerName)
+	tracerProvider *sdktrace.TracerProvider
+)
+
+// tracingEnabled Returns true if the OTLP endpoint is configured.
+func tracingEnabled() bool {
+	return os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != ""
+}
+
+// tracingProtocol Returns the OTLP protocol of the traces, the default is 'http/protobuf'.
+func tracingProtocol() string {
+	for _, name := range []string{"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"} {
+		if v := strings.TrimSpace(os.Getenv(name)); v != "" {
+			return v
+		}
+	}
+	return "http/protobuf"
+}
+
+// newTracingExporter Returns the OTLP exporter of the configured protocol.
+func newTracingExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch protocol := tracingProtocol(); protocol {
+	case "grpc":
+		return otlptracegrpc.New(ctx)
+	case "http/protobuf":
+		return otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("the OTLP protocol '%v' is not supported, use 'http/protobuf' or 'grpc'", protocol)
+	}
+}
+
+// InitTracing Registers the global tracer provider. It is called once when the plugin starts.
+// If tracing is not configured, the spans are not recorded.
+func InitTracing(ctx context.Context) error {
+	if !tracingEnabled() {
+		return nil
+	}
+
+	exporter, err := newTracingExporter(ctx)
+	if err != nil {
+		return err
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over the provider name.
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", "terraform-provider-routeros")),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return err
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	return nil
+}
+
+// ShutdownTracing Exports the remaining spans. It is called when the plugin server stops.
+func ShutdownTracing(ctx context.Context) {
+	if tracerProvider != nil {
+		_ = tracerProvider.Shutdown(ctx)
+	}
+}
+
+// startOperationSpan Starts the span of a Terraform operation on a resource or data source.
+func startOperationSpan(ctx context.Context, name, operation string, d *schema.ResourceData) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name+"."+operation, trace.WithAttributes(
+		attribute.String("terraform.resource", name),
+		attribute.String("terraform.operation", operation),
+		attribute.String("terraform.id", d.Id()),
+	))
+}
+
+func endOperationSpan(span trace.Span, diags diag.Diagnostics) {
+	for _, e := range diags {
+		if e.Severity == diag.Error {
+			span.SetStatus(codes.Error, e.Summary)
+			break
+		}
+	}
+	span.End()
+}
+
+// startRequestSpan Starts the span of a single request to the router.
+func startRequestSpan(ctx context.Context, transport TransportType, method crudMethod, url *URL) (context.Context, trace.Span) {
+	command := strings.TrimPrefix(apiMethodName[method], "/")
+	return tracer.Start(ctx, "routeros "+command+" "+url.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("routeros.transport", transport.String()),
+			attribute.String("routeros.command", command),
+			attribute.String("routeros.path", url.Path),
+		))
+}
+
+func endRequestSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,64 @@
+package routeros
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestNewTracingExporter(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol string
+		traces   string
+		wantErr  bool
+	}{
+		{"Default", "", "", false},
+		{"gRPC", "grpc", "", false},
+		{"Traces protocol", "http/json", "http/protobuf", false},
+		{"JSON", "http/json", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", tt.protocol)
+			t.Setenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", tt.traces)
+
+			exporter, err := newTracingExporter(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newTracingExporter() error = %v, want an error %v", err, tt.wantErr)
+			}
+			if exporter != nil {
+				_ = exporter.Shutdown(context.Background())
+			}
+		})
+	}
+}
+
+func TestNewTracingExporter_Http(t *testing.T) {
+	var path, contentType, token string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType, token = r.URL.Path, r.Header.Get("Content-Type"), r.Header.Get("X-Token")
+	}))
+	defer srv.Close()
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", srv.URL)
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "X-Token=secret")
+	t.Setenv("OTEL_EXPORTER_OTLP_TIMEOUT", "1000")
+
+	exporter, err := newTracingExporter(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	_, span := tp.Tracer(tracerName).Start(context.Background(), "routeros add /ip/address")
+	span.End()
+	_ = tp.Shutdown(context.Background())
+
+	if path != "/v1/traces" || contentType != "application/x-protobuf" || token != "secret" {
+		t.Errorf("OTLP request: path = %v, content type = %v, token = %v", path, contentType, token)
+	}
+}
@@ -10,19 +10,17 @@ var (
 	ErrorMsgGet    = "An error was encountered while sending a GET request to the API: %v"
 	ErrorMsgPatch  = "An error was encountered while sending a PATCH request to the API: %v"
 	ErrorMsgDelete = "An error was encountered while sending a DELETE request to the API: %v"
-
-	RouterOSVersion string
 )
 
 // Generate the resources drift:
 //
 //go:generate go run ../tools/drift/main.go
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"hosturl": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				DefaultFunc: schema.MultiEnvDefaultFunc(
 					[]string{"ROS_HOSTURL", "MIKROTIK_HOST"},
 					nil,
@@ -36,14 +34,31 @@ func Provider() *schema.Provider {
 		* https://router.local
 		* router.local
 		* 127.0.0.1
+	* SSH: ssh://host[:port]
+		* ssh://router.local
+		* ssh://router.local:2222
+	  Requires RouterOS 7.13 or later. The host key is checked against ~/.ssh/known_hosts unless insecure is set.
+	* IPv6 literals can be used with any transport, the zone ID is optional:
+		* apis://[2001:db8::1]:8729
+		* ssh://[fe80::1%ether1]
 
 
 	export ROS_HOSTURL=router.local or export MIKROTIK_HOST=router.local
 `,
 			},
+			"failover_hosts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Other addresses of the same router: host[:port] or URLs with the hosturl scheme. " +
+					"They are tried in order if the connection to hosturl fails, and the provider fails over to " +
+					"the next address if the connection is lost during the apply.",
+			},
 			"username": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				DefaultFunc: schema.MultiEnvDefaultFunc(
 					[]string{"ROS_USERNAME", "MIKROTIK_USER"},
 					nil,
@@ -64,6 +79,89 @@ func Provider() *schema.Provider {
 				Description: "Password for the MikroTik user (env: ROS_PASSWORD | MIKROTIK_PASSWORD).",
 				Sensitive:   true,
 			},
+			"profile": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_PROFILE"},
+					nil,
+				),
+				Description: "Name of the connection profile. The connection attributes are read from the environment " +
+					"variables with the profile name suffix: ROS_HOSTURL_<profile>, ROS_USERNAME_<profile>, " +
+					"ROS_PASSWORD_<profile>, ROS_CA_CERTIFICATE_<profile>, ROS_INSECURE_<profile>, etc. " +
+					"Attributes set in the provider block take precedence (env: ROS_PROFILE).",
+			},
+			"credentials_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_CREDENTIALS_COMMAND"},
+					nil,
+				),
+				Description: `A shell command that prints the router credentials as JSON. Non-empty values override hosturl, username and password (env: ROS_CREDENTIALS_COMMAND).
+
+
+	{"hosturl": "https://router.local", "username": "admin", "password": "secret"}
+`,
+			},
+			"vault_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_VAULT_ADDR"},
+					nil,
+				),
+				Description: "Address of the HashiCorp Vault server to read the router credentials from (env: ROS_VAULT_ADDR).",
+			},
+			"vault_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_VAULT_PATH"},
+					nil,
+				),
+				Description: "Path of the KV secret with the hosturl, username and password keys, e.g. " +
+					"secret/data/routers/core for KV v2 (env: ROS_VAULT_PATH).",
+			},
+			"vault_auth_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_VAULT_AUTH_METHOD"},
+					"token",
+				),
+				Description:  "Vault auth method: token or approle (env: ROS_VAULT_AUTH_METHOD).",
+				ValidateFunc: validation.StringInSlice([]string{"token", "approle"}, false),
+			},
+			"vault_token": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_VAULT_TOKEN", "VAULT_TOKEN"},
+					nil,
+				),
+				Description: "Vault token for the token auth method (env: ROS_VAULT_TOKEN | VAULT_TOKEN).",
+				Sensitive:   true,
+			},
+			"vault_role_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_VAULT_ROLE_ID"},
+					nil,
+				),
+				Description: "Role ID for the approle auth method (env: ROS_VAULT_ROLE_ID).",
+			},
+			"vault_secret_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_VAULT_SECRET_ID"},
+					nil,
+				),
+				Description: "Secret ID for the approle auth method (env: ROS_VAULT_SECRET_ID).",
+				Sensitive:   true,
+			},
 			"ca_certificate": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -71,7 +169,58 @@ func Provider() *schema.Provider {
 					[]string{"ROS_CA_CERTIFICATE", "MIKROTIK_CA_CERTIFICATE"},
 					nil,
 				),
-				Description: "Path to MikroTik's certificate authority file (env: ROS_CA_CERTIFICATE | MIKROTIK_CA_CERTIFICATE).",
+				Description: "Path to MikroTik's certificate authority file or PEM-encoded certificates " +
+					"(env: ROS_CA_CERTIFICATE | MIKROTIK_CA_CERTIFICATE).",
+			},
+			"tls_client_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_TLS_CLIENT_CERTIFICATE", "MIKROTIK_TLS_CLIENT_CERTIFICATE"},
+					nil,
+				),
+				Description: "Path to the client certificate file or PEM-encoded certificate used for mutual TLS authentication " +
+					"(env: ROS_TLS_CLIENT_CERTIFICATE | MIKROTIK_TLS_CLIENT_CERTIFICATE).",
+			},
+			"tls_client_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_TLS_CLIENT_KEY", "MIKROTIK_TLS_CLIENT_KEY"},
+					nil,
+				),
+				Description: "Path to the private key file or PEM-encoded private key of the client certificate " +
+					"(env: ROS_TLS_CLIENT_KEY | MIKROTIK_TLS_CLIENT_KEY).",
+				Sensitive: true,
+			},
+			"tls_min_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_TLS_MIN_VERSION"},
+					nil,
+				),
+				Description:  "Minimum TLS version: 1.0, 1.1, 1.2 or 1.3 (env: ROS_TLS_MIN_VERSION).",
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "1.1", "1.2", "1.3"}, false),
+			},
+			"tls_cipher_suites": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "List of allowed TLS 1.0-1.2 cipher suites (IANA names), e.g. " +
+					"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. TLS 1.3 cipher suites are not configurable.",
+			},
+			"tls_fingerprint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_TLS_FINGERPRINT"},
+					nil,
+				),
+				Description: "SHA-256 fingerprint of the router certificate (hex, colons are allowed). The connection " +
+					"is accepted if the certificate matches, without CA validation (env: ROS_TLS_FINGERPRINT).",
 			},
 			"insecure": {
 				Type:     schema.TypeBool,
@@ -80,7 +229,74 @@ func Provider() *schema.Provider {
 					[]string{"ROS_INSECURE", "MIKROTIK_INSECURE"},
 					false,
 				),
-				Description: "Whether to verify the SSL certificate or not (env: ROS_INSECURE | MIKROTIK_INSECURE).",
+				Description: "Whether to verify the SSL certificate (or the SSH host key) or not (env: ROS_INSECURE | MIKROTIK_INSECURE).",
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_READ_ONLY"},
+					false,
+				),
+				Description: "Reject all requests that change the router configuration. Reads and data sources " +
+					"continue to work (env: ROS_READ_ONLY).",
+			},
+			"ignore_server_defaults": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_IGNORE_SERVER_DEFAULTS"},
+					false,
+				),
+				Description: "Suppress the diffs of the attributes that are not configured when the router returns " +
+					"its default values for them, e.g. after a RouterOS upgrade has changed the defaults " +
+					"(env: ROS_IGNORE_SERVER_DEFAULTS).",
+			},
+			"conflict_check": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_CONFLICT_CHECK"},
+					nil,
+				),
+				Description: "Check at plan time whether the objects of the new resources (IP addresses, DNS records, " +
+					"bridge ports, list members) already exist on the router: `error` fails the plan, `warn` only " +
+					"writes the conflicts to the Terraform log (`TF_LOG=WARN`) and is not shown in the plan output " +
+					"(env: ROS_CONFLICT_CHECK).",
+				ValidateFunc: validation.StringInSlice([]string{conflictCheckWarn, conflictCheckError}, false),
+			},
+			"audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_AUDIT_LOG_PATH"},
+					nil,
+				),
+				Description: "Path of the file to append a JSON record of every request sent to the router: the " +
+					"operation, the HTTP method and the response status code for REST, the result and the error. " +
+					"Passwords, secrets, keys and scripts are masked (env: ROS_AUDIT_LOG_PATH).",
+			},
+			"metrics_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_METRICS_PATH"},
+					nil,
+				),
+				Description: "Path of the file to write the provider metrics in the Prometheus text format when " +
+					"Terraform finishes: the number of requests per path, request latencies, retries and the " +
+					"duration of the resource operations (env: ROS_METRICS_PATH).",
+			},
+			"managed_comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_MANAGED_COMMENT"},
+					nil,
+				),
+				Description: "Marker that is prepended to the comment of the objects created by the provider, " +
+					"e.g. \"[terraform]\". It is removed when the objects are read, so it does not cause diffs " +
+					"(env: ROS_MANAGED_COMMENT).",
 			},
 			"suppress_syso_del_warn": {
 				Type:     schema.TypeBool,
@@ -101,6 +317,16 @@ func Provider() *schema.Provider {
 				Description: "RouterOS version for which resource schemes will be adapted. The version obtained from " +
 					"MikroTik will be used if not specified (env: ROS_VERSION).",
 			},
+			"skip_version_detection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_SKIP_VERSION_DETECTION"},
+					false,
+				),
+				Description: "Do not read the RouterOS version from the router. Resource schemes are adapted only to " +
+					"the routeros_version if it is specified (env: ROS_SKIP_VERSION_DETECTION).",
+			},
 			"rest_timeout": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -108,6 +334,180 @@ func Provider() *schema.Provider {
 				Description:  "HTTP Client Timeout",
 				ValidateFunc: validation.IntAtLeast(5),
 			},
+			"user_agent_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_USER_AGENT_SUFFIX"},
+					nil,
+				),
+				Description: "A string appended to the User-Agent header of REST requests (env: ROS_USER_AGENT_SUFFIX).",
+			},
+			"extra_headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Additional HTTP headers sent with every REST request, e.g. for a reverse proxy in front " +
+					"of the router.",
+			},
+			"rest_compression": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_REST_COMPRESSION"},
+					true,
+				),
+				Description: "Request gzip-compressed REST responses (Accept-Encoding: gzip), they are supported " +
+					"by RouterOS 7.9 and later (env: ROS_REST_COMPRESSION).",
+			},
+			"rest_max_idle_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "Maximum number of idle (keep-alive) REST connections to the router.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rest_page_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_REST_PAGE_SIZE"},
+					0,
+				),
+				Description: "Read data source tables larger than this number of items in pages, e.g. large address " +
+					"lists or DHCP lease tables. The REST transport only, 0 disables paging (env: ROS_REST_PAGE_SIZE).",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"proxy_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_PROXY_URL"},
+					nil,
+				),
+				Description: "URL of the HTTP(S) proxy for the REST transport, e.g. http://proxy.local:3128. " +
+					"The HTTPS_PROXY, HTTP_PROXY and NO_PROXY variables are used if not specified (env: ROS_PROXY_URL).",
+			},
+			"bastion_host": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_BASTION_HOST"},
+					nil,
+				),
+				Description: "SSH jump host (host[:port]) to tunnel the connection to the router through " +
+					"(env: ROS_BASTION_HOST).",
+			},
+			"bastion_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_BASTION_USER"},
+					nil,
+				),
+				Description: "Username for the SSH jump host (env: ROS_BASTION_USER).",
+			},
+			"bastion_private_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_BASTION_PRIVATE_KEY"},
+					nil,
+				),
+				Description: "Path to the private key file or PEM-encoded private key for the SSH jump host " +
+					"(env: ROS_BASTION_PRIVATE_KEY).",
+				Sensitive: true,
+			},
+			"socks_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_SOCKS_PROXY"},
+					nil,
+				),
+				Description: "URL of the SOCKS5 proxy for the API and SSH transports: socks5://[user:password@]host:port " +
+					"(env: ROS_SOCKS_PROXY).",
+			},
+			"default_create_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_DEFAULT_CREATE_TIMEOUT"},
+					nil,
+				),
+				Description: "Default timeout of the create operation for resources without their own timeouts or " +
+					"the `timeouts` block, e.g. 30s or 5m (env: ROS_DEFAULT_CREATE_TIMEOUT).",
+				ValidateFunc: ValidationTime,
+			},
+			"default_read_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_DEFAULT_READ_TIMEOUT"},
+					nil,
+				),
+				Description: "Default timeout of the read operation for resources without their own timeouts or " +
+					"the `timeouts` block, e.g. 30s or 5m (env: ROS_DEFAULT_READ_TIMEOUT).",
+				ValidateFunc: ValidationTime,
+			},
+			"default_update_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_DEFAULT_UPDATE_TIMEOUT"},
+					nil,
+				),
+				Description: "Default timeout of the update operation for resources without their own timeouts or " +
+					"the `timeouts` block, e.g. 30s or 5m (env: ROS_DEFAULT_UPDATE_TIMEOUT).",
+				ValidateFunc: ValidationTime,
+			},
+			"default_delete_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_DEFAULT_DELETE_TIMEOUT"},
+					nil,
+				),
+				Description: "Default timeout of the delete operation for resources without their own timeouts or " +
+					"the `timeouts` block, e.g. 30s or 5m (env: ROS_DEFAULT_DELETE_TIMEOUT).",
+				ValidateFunc: ValidationTime,
+			},
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_MAX_RETRIES"},
+					0,
+				),
+				Description: "Number of times a request is repeated after a transient error: timeout, connection reset " +
+					"or a 'busy' response of RouterOS. The requests that create or move objects are only repeated if " +
+					"they have not reached the router (env: ROS_MAX_RETRIES).",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_backoff": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_RETRY_BACKOFF"},
+					"1s",
+				),
+				Description:  "The delay before the first retry, it is doubled after each attempt (env: ROS_RETRY_BACKOFF).",
+				ValidateFunc: ValidationTime,
+			},
+			"reboot_wait_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc(
+					[]string{"ROS_REBOOT_WAIT_TIMEOUT"},
+					nil,
+				),
+				Description: "How long to wait for the router to return after a request that reboots it (package " +
+					"installation, `apply_changes`, `/system/reboot`), e.g. `5m`. The lost connection is established " +
+					"again and the subsequent requests continue. Disabled if not specified (env: ROS_REBOOT_WAIT_TIMEOUT).",
+				ValidateFunc: ValidationTime,
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 
@@ -127,10 +527,13 @@ func Provider() *schema.Provider {
 			"routeros_ip_dns_forwarders":               ResourceIpDnsForwarders(),
 			"routeros_ip_dns_record":                   ResourceDnsRecord(),
 			"routeros_ip_firewall_addr_list":           ResourceIPFirewallAddrList(),
+			"routeros_ip_firewall_addr_list_set":       ResourceIPFirewallAddrListSet(),
 			"routeros_ip_firewall_connection_tracking": ResourceIPConnectionTracking(),
 			"routeros_ip_firewall_filter":              ResourceIPFirewallFilter(),
+			"routeros_ip_firewall_filter_chain":        ResourceIPFirewallFilterChain(),
 			"routeros_ip_firewall_mangle":              ResourceIPFirewallMangle(),
 			"routeros_ip_firewall_nat":                 ResourceIPFirewallNat(),
+			"routeros_ip_firewall_nat_chain":           ResourceIPFirewallNatChain(),
 			"routeros_ip_firewall_raw":                 ResourceIPFirewallRaw(),
 			"routeros_ip_hotspot":                      ResourceIpHotspot(),
 			"routeros_ip_hotspot_ip_binding":           ResourceIpHotspotIpBinding(),
@@ -169,17 +572,20 @@ func Provider() *schema.Provider {
 			"routeros_ipv6_settings":                   ResourceIpv6Settings(),
 
 			// Aliases for IP objects to retain compatibility between original and fork
-			"routeros_dhcp_client":         ResourceDhcpClient(),
-			"routeros_dhcp_client_option":  ResourceDhcpClientOption(),
-			"routeros_dhcp_server":         ResourceDhcpServer(),
-			"routeros_dhcp_server_network": ResourceDhcpServerNetwork(),
-			"routeros_dhcp_server_lease":   ResourceDhcpServerLease(),
-			"routeros_firewall_addr_list":  ResourceIPFirewallAddrList(),
-			"routeros_firewall_filter":     ResourceIPFirewallFilter(),
-			"routeros_firewall_mangle":     ResourceIPFirewallMangle(),
-			"routeros_firewall_nat":        ResourceIPFirewallNat(),
-			"routeros_dns":                 ResourceDns(),
-			"routeros_dns_record":          ResourceDnsRecord(),
+			"routeros_dhcp_client":            ResourceDhcpClient(),
+			"routeros_dhcp_client_option":     ResourceDhcpClientOption(),
+			"routeros_dhcp_server":            ResourceDhcpServer(),
+			"routeros_dhcp_server_network":    ResourceDhcpServerNetwork(),
+			"routeros_dhcp_server_lease":      ResourceDhcpServerLease(),
+			"routeros_firewall_addr_list":     ResourceIPFirewallAddrList(),
+			"routeros_firewall_addr_list_set": ResourceIPFirewallAddrListSet(),
+			"routeros_firewall_filter":        ResourceIPFirewallFilter(),
+			"routeros_firewall_filter_chain":  ResourceIPFirewallFilterChain(),
+			"routeros_firewall_mangle":        ResourceIPFirewallMangle(),
+			"routeros_firewall_nat":           ResourceIPFirewallNat(),
+			"routeros_firewall_nat_chain":     ResourceIPFirewallNatChain(),
+			"routeros_dns":                    ResourceDns(),
+			"routeros_dns_record":             ResourceDnsRecord(),
 
 			// Interface Objects
 			"routeros_interface_6to4":                           ResourceInterface6to4(),
@@ -204,6 +610,7 @@ func Provider() *schema.Provider {
 			"routeros_interface_ipip":                           ResourceInterfaceIPIP(),
 			"routeros_interface_list":                           ResourceInterfaceList(),
 			"routeros_interface_list_member":                    ResourceInterfaceListMember(),
+			"routeros_interface_list_member_set":                ResourceInterfaceListMemberSet(),
 			"routeros_interface_lte":                            ResourceInterfaceLte(),
 			"routeros_interface_lte_apn":                        ResourceInterfaceLteApn(),
 			"routeros_interface_l2tp_client":                    ResourceInterfaceL2tpClient(),
@@ -245,10 +652,14 @@ func Provider() *schema.Provider {
 			"routeros_disk_settings":                   ResourceDiskSettings(),
 			"routeros_ip_cloud":                        ResourceIpCloud(),
 			"routeros_ip_cloud_advanced":               ResourceIpCloudAdvanced(),
+			"routeros_partitions":                      ResourcePartitions(),
+			"routeros_port":                            ResourcePort(),
+			"routeros_special_login":                   ResourceSpecialLogin(),
 			"routeros_system_certificate":              ResourceSystemCertificate(),
 			"routeros_system_certificate_scep_server":  ResourceCertificateScepServer(),
 			"routeros_certificate_scep_server":         ResourceCertificateScepServer(),
 			"routeros_system_clock":                    ResourceSystemClock(),
+			"routeros_system_device_mode":              ResourceSystemDeviceMode(),
 			"routeros_system_identity":                 ResourceSystemIdentity(),
 			"routeros_system_led":                      ResourceSystemLed(),
 			"routeros_system_led_settings":             ResourceSystemLedSettings(),
@@ -257,6 +668,9 @@ func Provider() *schema.Provider {
 			"routeros_system_note":                     ResourceSystemNote(),
 			"routeros_system_ntp_client":               ResourceSystemNtpClient(),
 			"routeros_system_ntp_server":               ResourceSystemNtpServer(),
+			"routeros_system_package":                  ResourceSystemPackage(),
+			"routeros_system_ptp":                      ResourceSystemPtp(),
+			"routeros_system_ptp_port":                 ResourceSystemPtpPort(),
 			"routeros_system_routerboard_button_mode":  ResourceSystemRouterboardButtonMode(),
 			"routeros_system_routerboard_button_reset": ResourceSystemRouterboardButtonReset(),
 			"routeros_system_routerboard_button_wps":   ResourceSystemRouterboardButtonWps(),
@@ -296,6 +710,13 @@ func Provider() *schema.Provider {
 			// File objects
 			"routeros_file": ResourceFile(),
 
+			// IoT
+			"routeros_iot_lora":              ResourceIotLora(),
+			"routeros_iot_lora_server":       ResourceIotLoraServer(),
+			"routeros_iot_modbus":            ResourceIotModbus(),
+			"routeros_iot_mqtt_broker":       ResourceIotMqttBroker(),
+			"routeros_iot_mqtt_subscription": ResourceIotMqttSubscription(),
+
 			// Routing
 			"routeros_routing_bgp_connection":       ResourceRoutingBGPConnection(),
 			"routeros_routing_bgp_template":         ResourceRoutingBGPTemplate(),
@@ -337,6 +758,7 @@ func Provider() *schema.Provider {
 			// Helpers
 			"routeros_wireguard_keys": ResourceWireguardKeys(),
 			"routeros_move_items":     ResourceMoveItems(),
+			"routeros_generic":        ResourceGeneric(),
 
 			// Tools
 			"routeros_tool_bandwidth_server":   ResourceToolBandwidthServer(),
@@ -384,32 +806,69 @@ func Provider() *schema.Provider {
 			"routeros_zerotier_interface":  ResourceZerotierInterface(),
 
 			// Queue
-			"routeros_queue_simple": ResourceQueueSimple(),
-			"routeros_queue_tree":   ResourceQueueTree(),
-			"routeros_queue_type":   ResourceQueueType(),
+			"routeros_queue_interface": ResourceQueueInterface(),
+			"routeros_queue_simple":    ResourceQueueSimple(),
+			"routeros_queue_tree":      ResourceQueueTree(),
+			"routeros_queue_type":      ResourceQueueType(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"routeros_files":                   DatasourceFiles(),
-			"routeros_interfaces":              DatasourceInterfaces(),
-			"routeros_interface_bridge_filter": DatasourceInterfaceBridgeFilter(),
-			"routeros_ip_addresses":            DatasourceIPAddresses(),
-			"routeros_ip_arp":                  DatasourceIpArp(),
-			"routeros_ip_dhcp_server_leases":   DatasourceIpDhcpServerLeases(),
-			"routeros_ip_firewall":             DatasourceIPFirewall(),
-			"routeros_ip_routes":               DatasourceIPRoutes(),
-			"routeros_ip_services":             DatasourceIPServices(),
-			"routeros_ipv6_addresses":          DatasourceIPv6Addresses(),
-			"routeros_ipv6_firewall":           DatasourceIPv6Firewall(),
-			"routeros_system_resource":         DatasourceSystemResource(),
-			"routeros_system_routerboard":      DatasourceSystemRouterboard(),
-			"routeros_wifi_easy_connect":       DatasourceWiFiEasyConnect(),
-			"routeros_x509":                    DatasourceX509(),
+			"routeros_certificates":               DatasourceCertificates(),
+			"routeros_files":                      DatasourceFiles(),
+			"routeros_generic":                    DatasourceGeneric(),
+			"routeros_interfaces":                 DatasourceInterfaces(),
+			"routeros_interface_bridge_filter":    DatasourceInterfaceBridgeFilter(),
+			"routeros_interface_ethernet_monitor": DatasourceInterfaceEthernetMonitor(),
+			"routeros_interface_lte_monitor":      DatasourceInterfaceLteMonitor(),
+			"routeros_interface_wireguard_peers":  DatasourceInterfaceWireguardPeers(),
+			"routeros_ip_addresses":               DatasourceIPAddresses(),
+			"routeros_ip_arp":                     DatasourceIpArp(),
+			"routeros_ip_cloud":                   DatasourceIpCloud(),
+			"routeros_ip_dhcp_server_leases":      DatasourceIpDhcpServerLeases(),
+			"routeros_ip_firewall":                DatasourceIPFirewall(),
+			"routeros_ip_hotspot_active":          DatasourceIpHotspotActive(),
+			"routeros_ip_neighbors":               DatasourceIpNeighbors(),
+			"routeros_ip_routes":                  DatasourceIPRoutes(),
+			"routeros_ip_services":                DatasourceIPServices(),
+			"routeros_ipv6_addresses":             DatasourceIPv6Addresses(),
+			"routeros_ipv6_firewall":              DatasourceIPv6Firewall(),
+			"routeros_ping":                       DatasourcePing(),
+			"routeros_ppp_active":                 DatasourcePPPActive(),
+			"routeros_routing_bgp_sessions":       DatasourceRoutingBgpSessions(),
+			"routeros_routing_ospf_neighbors":     DatasourceRoutingOspfNeighbors(),
+			"routeros_system_health":              DatasourceSystemHealth(),
+			"routeros_system_license":             DatasourceSystemLicense(),
+			"routeros_system_logs":                DatasourceSystemLogs(),
+			"routeros_system_packages":            DatasourceSystemPackages(),
+			"routeros_system_resource":            DatasourceSystemResource(),
+			"routeros_system_resources":           DatasourceSystemResources(),
+			"routeros_system_routerboard":         DatasourceSystemRouterboard(),
+			"routeros_system_update":              DatasourceSystemUpdate(),
+			"routeros_system_user_active":         DatasourceSystemUserActive(),
+			"routeros_traceroute":                 DatasourceTraceroute(),
+			"routeros_wifi_easy_connect":          DatasourceWiFiEasyConnect(),
+			"routeros_wifi_registration":          DatasourceWifiRegistration(),
+			"routeros_wireless_registration":      DatasourceWirelessRegistration(),
+			"routeros_x509":                       DatasourceX509(),
 
 			// Aliases for entries that have been renamed
 			"routeros_firewall": DatasourceIPFirewall(),
 		},
 		ConfigureContextFunc: NewClient,
 	}
+
+	// The version gates are taken from the attributes before they are copied by the other wrappers.
+	addVersionGates(p)
+	addWriteOnlyAttributes(p)
+	addResourceIdentities(p)
+	addAdoptExisting(p)
+	addDestroyBehavior(p)
+	addIgnoreChangesServer(p)
+	addServerDefaultsSuppression(p)
+	addConflictChecks(p)
+	wrapOperations(p)
+	addTransportOverride(p)
+
+	return p
 }
 
 func NewProvider() *schema.Provider {
@@ -0,0 +1,100 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// KeyAdoptExisting The objects that already exist on the router are adopted on create: the object with the same
+// natural key (the fields of the resource identity) is updated instead of failing with 'already have such entry'.
+const KeyAdoptExisting = "adopt_existing"
+
+// addAdoptExisting Adds the 'adopt_existing' attribute to the resources with the natural keys.
+func addAdoptExisting(p *schema.Provider) {
+	for name, keys := range resourceIdentityFields {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			panic("[addAdoptExisting] unknown resource: " + name)
+		}
+		if r.CreateContext == nil || r.UpdateContext == nil {
+			continue
+		}
+
+		addResourceAdoptExisting(r, keys)
+	}
+}
+
+func addResourceAdoptExisting(r *schema.Resource, keys []string) {
+	r.Schema[KeyAdoptExisting] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: fmt.Sprintf("If an object with the same %v already exists, it is adopted and updated on "+
+			"create instead of failing with the `already have such entry` error.", joinIdentityFields(keys)),
+	}
+
+	create, update := r.CreateContext, r.UpdateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if !d.Get(KeyAdoptExisting).(bool) {
+			return create(ctx, d, m)
+		}
+
+		id, err := existingItemId(r.Schema, keys, d, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return diag.FromErr(err)
+		}
+		if id == "" {
+			return create(ctx, d, m)
+		}
+
+		ColorizedMessage(ctx, INFO, "Adopting the existing object", map[string]interface{}{"id": id})
+		d.SetId(id)
+		return update(ctx, d, m)
+	}
+}
+
+// existingItemId Returns the ID of the object with the same natural key or an empty string if there is no such
+// object. The empty key fields (a rule without a comment) can not identify the object, nothing is adopted.
+func existingItemId(s map[string]*schema.Schema, keys []string, d *schema.ResourceData, c Client) (string, error) {
+	var filter []string
+	for _, key := range keys {
+		v := d.Get(key).(string)
+		if v == "" {
+			return "", nil
+		}
+		filter = append(filter, SnakeToKebab(key)+"="+v)
+	}
+
+	meta := GetMetadata(s)
+	res, err := ReadItemsFiltered(filter, meta.Path, c)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(*res) {
+	case 0:
+		return "", nil
+	case 1:
+		return (*res)[0].GetID(meta.IdType), nil
+	default:
+		return "", fmt.Errorf("more than one object can be adopted: %v", filter)
+	}
+}
+
+func joinIdentityFields(keys []string) string {
+	var res string
+	for i, key := range keys {
+		switch {
+		case i == 0:
+		case i == len(keys)-1:
+			res += " and "
+		default:
+			res += ", "
+		}
+		res += "`" + key + "`"
+	}
+	return res
+}
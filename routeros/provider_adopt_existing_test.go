@@ -0,0 +1,79 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testAdoptClient struct {
+	testOperationClient
+	items []MikrotikItem
+	reads int
+}
+
+func (c *testAdoptClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	c.reads++
+	*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	return nil
+}
+
+func TestAdoptExisting(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    map[string]interface{}
+		items     []MikrotikItem
+		wantCall  string
+		wantId    string
+		wantReads int
+		wantErr   bool
+	}{
+		{"Disabled", map[string]interface{}{"chain": "input", "comment": "ssh"},
+			[]MikrotikItem{{".id": "*1"}}, "create", "*new", 0, false},
+		{"Existing", map[string]interface{}{"chain": "input", "comment": "ssh", KeyAdoptExisting: true},
+			[]MikrotikItem{{".id": "*1"}}, "update", "*1", 1, false},
+		{"Not found", map[string]interface{}{"chain": "input", "comment": "ssh", KeyAdoptExisting: true},
+			nil, "create", "*new", 1, false},
+		{"Empty key", map[string]interface{}{"chain": "input", KeyAdoptExisting: true},
+			[]MikrotikItem{{".id": "*1"}}, "create", "*new", 0, false},
+		{"Ambiguous", map[string]interface{}{"chain": "input", "comment": "ssh", KeyAdoptExisting: true},
+			[]MikrotikItem{{".id": "*1"}, {".id": "*2"}}, "", "", 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var call string
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					MetaResourcePath: PropResourcePath("/ip/firewall/filter"),
+					MetaId:           PropId(Id),
+					"chain":          {Type: schema.TypeString, Required: true},
+					"comment":        {Type: schema.TypeString, Optional: true},
+				},
+				CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+					call = "create"
+					d.SetId("*new")
+					return nil
+				},
+				UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+					call = "update"
+					return nil
+				},
+			}
+			addResourceAdoptExisting(r, []string{"chain", "comment"})
+
+			c := &testAdoptClient{items: tt.items}
+			d := schema.TestResourceDataRaw(t, r.Schema, tt.config)
+			diags := r.CreateContext(context.Background(), d, c)
+
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("create diagnostics = %v, want error %v", diags, tt.wantErr)
+			}
+			if call != tt.wantCall || d.Id() != tt.wantId || c.reads != tt.wantReads {
+				t.Errorf("call = %v, id = %v, reads = %v, want %v, %v, %v", call, d.Id(), c.reads,
+					tt.wantCall, tt.wantId, tt.wantReads)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Conflict check modes of the 'conflict_check' provider option. Only the error mode is visible in the plan, the
+// warn mode writes the conflicts to the Terraform log.
+const (
+	conflictCheckWarn  = "warn"
+	conflictCheckError = "error"
+)
+
+// resourceConflictFields The fields of the objects that the router does not allow to be duplicated. The new
+// resources are checked at plan time if the 'conflict_check' provider option is set.
+var resourceConflictFields = map[string][]string{
+	"routeros_interface_bridge_port": {"interface"},
+	"routeros_interface_list_member": {"list", "interface"},
+	"routeros_ip_address":            {"address"},
+	"routeros_ip_dns_record":         {"name", "type"},
+	"routeros_ip_firewall_addr_list": {"list", "address"},
+	"routeros_ipv6_address":          {"address"},
+}
+
+// addConflictChecks Adds the plan time check of the existing objects to the resources with the conflict fields.
+func addConflictChecks(p *schema.Provider) {
+	for name, keys := range resourceConflictFields {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			panic("[addConflictChecks] unknown resource: " + name)
+		}
+
+		r.CustomizeDiff = conflictCheckCustomizeDiff(name, keys, r.Schema, r.CustomizeDiff)
+	}
+}
+
+func conflictCheckCustomizeDiff(name string, keys []string, s map[string]*schema.Schema,
+	customize schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if err := checkConflicts(ctx, name, keys, s, d, m); err != nil {
+			return err
+		}
+
+		if customize != nil {
+			return customize(ctx, d, m)
+		}
+		return nil
+	}
+}
+
+// checkConflicts Looks for the router objects with the same conflict fields as the new resource: they are not
+// in the state and the create would fail. The objects that are adopted are not conflicts.
+func checkConflicts(ctx context.Context, name string, keys []string, s map[string]*schema.Schema,
+	d *schema.ResourceDiff, m interface{}) error {
+
+	c, ok := m.(Client)
+	if !ok || d.Id() != "" || c.GetExtraParams() == nil {
+		return nil
+	}
+
+	mode := c.GetExtraParams().ConflictCheck
+	if mode == "" {
+		return nil
+	}
+	if _, ok := s[KeyAdoptExisting]; ok && d.Get(KeyAdoptExisting).(bool) {
+		return nil
+	}
+
+	var filter []string
+	for _, key := range keys {
+		// The value of another resource is not known at plan time.
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		v := d.Get(key).(string)
+		if v == "" {
+			return nil
+		}
+		filter = append(filter, SnakeToKebab(key)+"="+v)
+	}
+
+	res, err := ReadItemsFiltered(filter, GetMetadata(s).Path, c, ".id")
+	if err != nil {
+		ColorizedMessage(ctx, WARN, "The conflicting objects are not checked", map[string]interface{}{"error": err})
+		return nil
+	}
+	if len(*res) == 0 {
+		return nil
+	}
+
+	var ids []string
+	for _, item := range *res {
+		ids = append(ids, item.GetID(Id))
+	}
+	msg := fmt.Sprintf("the object '%v' of the resource '%v' already exists on the router (%v), import it or "+
+		"remove it before the apply", strings.Join(filter, ","), name, strings.Join(ids, ","))
+
+	if mode == conflictCheckError {
+		return fmt.Errorf("%v", msg)
+	}
+	// CustomizeDiff cannot return warnings to the plan, the warning is only in the Terraform log.
+	ColorizedMessage(ctx, WARN, msg)
+	return nil
+}
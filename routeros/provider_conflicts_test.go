@@ -0,0 +1,66 @@
+package routeros
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestConflictCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      string
+		state     *terraform.InstanceState
+		adopt     bool
+		items     []MikrotikItem
+		wantReads int
+		wantErr   string
+	}{
+		{"Disabled", "", &terraform.InstanceState{}, false, []MikrotikItem{{".id": "*1"}}, 0, ""},
+		{"Warning", conflictCheckWarn, &terraform.InstanceState{}, false, []MikrotikItem{{".id": "*1"}}, 1, ""},
+		{"Conflict", conflictCheckError, &terraform.InstanceState{}, false, []MikrotikItem{{".id": "*1"}}, 1,
+			"the object 'address=10.0.0.1/24' of the resource 'routeros_ip_address' already exists on the router (*1)"},
+		{"No conflict", conflictCheckError, &terraform.InstanceState{}, false, nil, 1, ""},
+		{"Adopted", conflictCheckError, &terraform.InstanceState{}, true, []MikrotikItem{{".id": "*1"}}, 0, ""},
+		{"In state", conflictCheckError, &terraform.InstanceState{ID: "*1",
+			Attributes: map[string]string{"address": "10.0.0.1/24", "interface": "ether1"}}, false,
+			[]MikrotikItem{{".id": "*1"}}, 0, ""},
+	}
+	r := Provider().ResourcesMap["routeros_ip_address"]
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := r.CoreConfigSchema()
+
+			config := map[string]cty.Value{}
+			for name, ty := range s.ImpliedType().AttributeTypes() {
+				config[name] = cty.NullVal(ty)
+			}
+			config["address"], config["interface"] = cty.StringVal("10.0.0.1/24"), cty.StringVal("ether1")
+			if tt.adopt {
+				config[KeyAdoptExisting] = cty.True
+			}
+
+			// The same way as the plugin server passes the configuration.
+			c := terraform.NewResourceConfigShimmed(cty.ObjectVal(config), s)
+			c.CtyValue = cty.ObjectVal(config)
+			tt.state.RawConfig = cty.ObjectVal(config)
+
+			client := &testAdoptClient{items: tt.items}
+			client.extra = &ExtraParams{ConflictCheck: tt.mode}
+
+			_, err := r.Diff(context.Background(), tt.state, c, client)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("Diff() error = %v, want %v", err, tt.wantErr)
+			}
+			if client.reads != tt.wantReads {
+				t.Errorf("reads = %v, want %v", client.reads, tt.wantReads)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// KeyDestroyBehavior The destroy of the resource either removes the object from the router or only disables it,
+// so that the teardown can be reverted.
+const KeyDestroyBehavior = "destroy_behavior"
+
+const (
+	destroyBehaviorDelete  = "delete"
+	destroyBehaviorDisable = "disable"
+)
+
+// addDestroyBehavior Adds the 'destroy_behavior' attribute to the resources of the objects that can be disabled.
+func addDestroyBehavior(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		if attr, ok := r.Schema[KeyDisabled]; !ok || !attr.Optional || attr.ForceNew || r.DeleteContext == nil {
+			continue
+		}
+		if _, ok := r.Schema[MetaResourcePath]; !ok {
+			continue
+		}
+
+		addResourceDestroyBehavior(r)
+	}
+}
+
+func addResourceDestroyBehavior(r *schema.Resource) {
+	r.Schema[KeyDestroyBehavior] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "What happens to the object when the resource is destroyed: `delete` removes it from the router " +
+			"(default), `disable` only sets `disabled = true` and keeps the object.",
+		ValidateFunc: validation.StringInSlice([]string{destroyBehaviorDelete, destroyBehaviorDisable}, false),
+	}
+
+	s, delete := r.Schema, r.DeleteContext
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get(KeyDestroyBehavior).(string) != destroyBehaviorDisable {
+			return delete(ctx, d, m)
+		}
+		return disableItem(ctx, s, d, m)
+	}
+}
+
+// disableItem Disables the object of the destroyed resource instead of removing it.
+func disableItem(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	metadata := GetMetadata(s)
+
+	id, err := dynamicIdLookup(metadata.IdType, metadata.Path, m.(Client), d)
+	if err != nil {
+		if err != errorNoLongerExists {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  errorNoLongerExists.Error(),
+			},
+		}
+	}
+
+	item := MikrotikItem{SnakeToKebab(KeyDisabled): BoolToMikrotikJSON(true)}
+	if _, err = UpdateItem(&ItemId{Id, id}, metadata.Path, item, m.(Client)); err != nil {
+		ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+		return diag.FromErr(err)
+	}
+
+	ColorizedMessage(ctx, INFO, "The object is disabled instead of removed", map[string]interface{}{"id": id})
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,79 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testDisableClient struct {
+	testOperationClient
+	items   []MikrotikItem
+	updated map[string]MikrotikItem
+}
+
+func (c *testDisableClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudRead:
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	case crudUpdate:
+		c.updated[url.Path] = item
+	}
+	return nil
+}
+
+func TestDestroyBehavior(t *testing.T) {
+	tests := []struct {
+		name        string
+		behavior    string
+		items       []MikrotikItem
+		wantDeleted bool
+		wantUpdated map[string]MikrotikItem
+		wantWarning bool
+	}{
+		{"Default", "", []MikrotikItem{{".id": "*1"}}, true, map[string]MikrotikItem{}, false},
+		{"Delete", destroyBehaviorDelete, []MikrotikItem{{".id": "*1"}}, true, map[string]MikrotikItem{}, false},
+		{"Disable", destroyBehaviorDisable, []MikrotikItem{{".id": "*1"}}, false,
+			map[string]MikrotikItem{"/ip/pool/*1": {"disabled": "yes"}}, false},
+		{"Disable removed", destroyBehaviorDisable, nil, false, map[string]MikrotikItem{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted bool
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					MetaResourcePath: PropResourcePath("/ip/pool"),
+					MetaId:           PropId(Id),
+					KeyDisabled:      PropDisabledRw,
+				},
+				DeleteContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+					deleted = true
+					d.SetId("")
+					return nil
+				},
+			}
+			addResourceDestroyBehavior(r)
+
+			c := &testDisableClient{items: tt.items, updated: map[string]MikrotikItem{}}
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{KeyDestroyBehavior: tt.behavior})
+			d.SetId("*1")
+
+			diags := r.DeleteContext(context.Background(), d, c)
+			if diags.HasError() {
+				t.Fatalf("delete diagnostics = %v", diags)
+			}
+			if hasWarning := len(diags) > 0; hasWarning != tt.wantWarning {
+				t.Errorf("delete diagnostics = %v, want a warning %v", diags, tt.wantWarning)
+			}
+			if deleted != tt.wantDeleted || d.Id() != "" {
+				t.Errorf("deleted = %v, id = %v, want %v", deleted, d.Id(), tt.wantDeleted)
+			}
+			if !reflect.DeepEqual(c.updated, tt.wantUpdated) {
+				t.Errorf("updated = %v, want %v", c.updated, tt.wantUpdated)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package routeros
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Provider-defined functions (Terraform 1.8+) for the conversion between Terraform values and RouterOS formats:
+// provider::routeros::to_ros_duration("1h30m")
+
+// providerFunction A function of one string argument that returns a string.
+type providerFunction struct {
+	summary     string
+	description string
+	param       string
+	paramDesc   string
+	call        func(string) (string, error)
+}
+
+var providerFunctions = map[string]providerFunction{
+	"to_ros_duration": {
+		summary: "Converts a duration into the RouterOS format",
+		description: "Converts a duration (`90m`, `1h30m`, `1d`, `01:30:00`) into the RouterOS format: `1h30m`. " +
+			"A number without units is seconds.",
+		param:     "duration",
+		paramDesc: "Duration to convert.",
+		call: func(s string) (string, error) {
+			d, err := ParseDuration(s, time.Second)
+			if err != nil {
+				return "", err
+			}
+			return formatRosDuration(d), nil
+		},
+	},
+	"from_ros_duration": {
+		summary: "Converts a RouterOS duration into the Terraform format",
+		description: "Converts a RouterOS duration (`1w2d03:04:05`, `1d2h`) into the format of the Terraform " +
+			"`timeadd` function: `218645s` is returned as `60h44m5s`.",
+		param:     "duration",
+		paramDesc: "RouterOS duration to convert.",
+		call: func(s string) (string, error) {
+			d, err := ParseDuration(s, time.Second)
+			if err != nil {
+				return "", err
+			}
+			return d.String(), nil
+		},
+	},
+	"cidr_to_netmask": {
+		summary:     "Returns the netmask of an IPv4 prefix",
+		description: "Returns the netmask of an IPv4 prefix: `192.168.88.0/24` is returned as `255.255.255.0`.",
+		param:       "cidr",
+		paramDesc:   "IPv4 prefix in the CIDR notation.",
+		call:        cidrToNetmask,
+	},
+	"mac_normalize": {
+		summary: "Converts a MAC address into the RouterOS format",
+		description: "Converts a MAC address (`00-0c-29-00-01-a0`, `000c.2900.01a0`, `000c290001a0`) into the " +
+			"RouterOS format: `00:0C:29:00:01:A0`.",
+		param:     "mac",
+		paramDesc: "MAC address to convert.",
+		call:      macNormalize,
+	},
+}
+
+// formatRosDuration Returns the duration in the RouterOS format: 1w2d3h4m5s500ms.
+func formatRosDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var sb strings.Builder
+	if d < 0 {
+		sb.WriteString("-")
+		d = -d
+	}
+
+	for _, u := range []struct {
+		name string
+		unit time.Duration
+	}{
+		{"w", 7 * 24 * time.Hour},
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+		{"ms", time.Millisecond},
+	} {
+		if n := d / u.unit; n > 0 {
+			fmt.Fprintf(&sb, "%d%s", n, u.name)
+			d -= n * u.unit
+		}
+	}
+
+	return sb.String()
+}
+
+func cidrToNetmask(s string) (string, error) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return "", err
+	}
+
+	if !prefix.Addr().Is4() {
+		return "", fmt.Errorf("netmask of the IPv6 prefix %q is not supported", s)
+	}
+
+	return net.IP(net.CIDRMask(prefix.Bits(), 32)).String(), nil
+}
+
+func macNormalize(s string) (string, error) {
+	// 000c290001a0
+	if len(s) == 12 {
+		if _, err := hex.DecodeString(s); err == nil {
+			var parts []string
+			for i := 0; i < len(s); i += 2 {
+				parts = append(parts, s[i:i+2])
+			}
+			s = strings.Join(parts, ":")
+		}
+	}
+
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return "", err
+	}
+
+	if len(mac) != 6 {
+		return "", fmt.Errorf("%q is not a 48-bit MAC address", s)
+	}
+
+	return strings.ToUpper(mac.String()), nil
+}
+
+func functionDefinitions() map[string]*tfprotov5.Function {
+	res := make(map[string]*tfprotov5.Function, len(providerFunctions))
+	for name, f := range providerFunctions {
+		res[name] = &tfprotov5.Function{
+			Parameters: []*tfprotov5.FunctionParameter{{
+				Name:            f.param,
+				Type:            tftypes.String,
+				Description:     f.paramDesc,
+				DescriptionKind: tfprotov5.StringKindMarkdown,
+			}},
+			Return:          &tfprotov5.FunctionReturn{Type: tftypes.String},
+			Summary:         f.summary,
+			Description:     f.description,
+			DescriptionKind: tfprotov5.StringKindMarkdown,
+		}
+	}
+	return res
+}
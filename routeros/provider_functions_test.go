@@ -0,0 +1,86 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderFunctions(t *testing.T) {
+	tests := []struct {
+		function string
+		arg      string
+		want     string
+		wantErr  bool
+	}{
+		{"to_ros_duration", "90m", "1h30m", false},
+		{"to_ros_duration", "1d", "1d", false},
+		{"to_ros_duration", "01:30:00", "1h30m", false},
+		{"to_ros_duration", "700", "11m40s", false},
+		{"to_ros_duration", "1500ms", "1s500ms", false},
+		{"to_ros_duration", "0", "0s", false},
+		{"to_ros_duration", "1x", "", true},
+		{"from_ros_duration", "1w2d03:04:05", "219h4m5s", false},
+		{"from_ros_duration", "1d2h", "26h0m0s", false},
+		{"cidr_to_netmask", "192.168.88.0/24", "255.255.255.0", false},
+		{"cidr_to_netmask", "10.0.0.0/9", "255.128.0.0", false},
+		{"cidr_to_netmask", "fd00::/64", "", true},
+		{"mac_normalize", "00-0c-29-00-01-a0", "00:0C:29:00:01:A0", false},
+		{"mac_normalize", "000c.2900.01a0", "00:0C:29:00:01:A0", false},
+		{"mac_normalize", "000c290001a0", "00:0C:29:00:01:A0", false},
+		{"mac_normalize", "00:0c:29", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.function+"("+tt.arg+")", func(t *testing.T) {
+			got, err := providerFunctions[tt.function].call(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("%v(%q) error = %v, wantErr %v", tt.function, tt.arg, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("%v(%q) = %q, want %q", tt.function, tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProtocolServer_CallFunction(t *testing.T) {
+	s := NewProtocolServer()
+	ctx := context.Background()
+
+	schema, err := s.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := schema.Functions["mac_normalize"]; !ok || len(schema.ResourceSchemas) == 0 {
+		t.Fatalf("GetProviderSchema() does not contain the functions and resources")
+	}
+
+	arg, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, "00-0c-29-00-01-a0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.CallFunction(ctx, &tfprotov5.CallFunctionRequest{
+		Name:      "mac_normalize",
+		Arguments: []*tfprotov5.DynamicValue{&arg},
+	})
+	if err != nil || resp.Error != nil {
+		t.Fatalf("CallFunction() error = %v, %v", err, resp.Error)
+	}
+
+	v, err := resp.Result.Unmarshal(tftypes.String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got string
+	if err = v.As(&got); err != nil || got != "00:0C:29:00:01:A0" {
+		t.Errorf("CallFunction() = %q, %v", got, err)
+	}
+
+	resp, err = s.CallFunction(ctx, &tfprotov5.CallFunctionRequest{Name: "unknown"})
+	if err != nil || resp.Error == nil {
+		t.Errorf("CallFunction(unknown) error = %v, %v, want the function error", err, resp.Error)
+	}
+}
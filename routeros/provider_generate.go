@@ -0,0 +1,280 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Generation of the configuration of an existing router: every object of the supported resources is written
+// as an import block and a resource block. With ImportsOnly the resource blocks can be generated by Terraform:
+// terraform plan -generate-config-out=generated.tf
+
+// GenerateOptions Options of the configuration generator.
+type GenerateOptions struct {
+	// Resource types to generate, all by default.
+	Resources []string
+	// Write only the import blocks.
+	ImportsOnly bool
+}
+
+var reLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// GenerateConfig Connects to the router configured by the ROS_* environment variables and writes the
+// configuration of its objects.
+func GenerateConfig(ctx context.Context, w io.Writer, opts GenerateOptions) error {
+	p := Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		return fmt.Errorf("failed to configure the provider: %v", diags[0].Summary)
+	}
+
+	return generateConfig(ctx, w, p.ResourcesMap, p.Meta().(Client), opts)
+}
+
+func generateConfig(ctx context.Context, w io.Writer, resources map[string]*schema.Resource, c Client,
+	opts GenerateOptions) error {
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for _, name := range generatedResources(resources, opts.Resources) {
+		r := resources[name]
+
+		objects, err := readObjects(ctx, r, c)
+		if err != nil {
+			log.Printf("[WARN] %v is skipped: %v", name, err)
+			continue
+		}
+
+		labels := map[string]struct{}{}
+		for _, d := range objects {
+			label := objectLabel(name, d, labels)
+
+			block := body.AppendNewBlock("import", nil).Body()
+			block.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: name}, hcl.TraverseAttr{Name: label}})
+			block.SetAttributeValue("id", cty.StringVal(d.Id()))
+			body.AppendNewline()
+
+			if opts.ImportsOnly {
+				continue
+			}
+
+			block = body.AppendNewBlock("resource", []string{name, label}).Body()
+			writeAttributes(block, r.Schema, d.Get)
+			body.AppendNewline()
+		}
+	}
+
+	_, err := f.WriteTo(w)
+	return err
+}
+
+// generatedResources Returns the sorted resource types with a path, an alias is replaced by the resource
+// with the longest name: routeros_interface_bridge instead of routeros_bridge.
+func generatedResources(resources map[string]*schema.Resource, filter []string) []string {
+	byPath := map[string]string{}
+	for name, r := range resources {
+		if len(filter) > 0 && !slices.Contains(filter, name) {
+			continue
+		}
+
+		// The path of the generic resource is configured.
+		path, ok := r.Schema[MetaResourcePath]
+		if !ok || r.ReadContext == nil || r.Importer == nil || name == "routeros_generic" {
+			continue
+		}
+
+		key := path.Default.(string)
+		if prev, ok := byPath[key]; !ok || len(name) > len(prev) || (len(name) == len(prev) && name < prev) {
+			byPath[key] = name
+		}
+	}
+
+	var res []string
+	for _, name := range byPath {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+
+	return res
+}
+
+// readObjects Reads all objects of the resource by the resource read function, the dynamic and built-in
+// objects are skipped.
+func readObjects(ctx context.Context, r *schema.Resource, c Client) ([]*schema.ResourceData, error) {
+	meta := GetMetadata(r.Schema)
+
+	var ids []string
+	items, err := ReadItems(nil, meta.Path, c)
+	switch {
+	case err == nil && (len(*items) != 1 || (*items)[0].GetID(Id) != ""):
+		for _, item := range *items {
+			if item["dynamic"] == "true" || item["builtin"] == "true" || item["default"] == "true" {
+				continue
+			}
+			if id := item.GetID(meta.IdType); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	case err == nil:
+		// A single object without ID: /ip/dns
+		ids = []string{strings.ReplaceAll(strings.TrimLeft(meta.Path, "/"), "/", ".")}
+	default:
+		return nil, err
+	}
+
+	var res []*schema.ResourceData
+	for _, id := range ids {
+		d := r.Data(nil)
+		d.SetId(id)
+
+		if diags := r.ReadContext(ctx, d, c); diags.HasError() {
+			return nil, fmt.Errorf("%v: %v", id, diags[0].Summary)
+		}
+		if d.Id() != "" {
+			res = append(res, d)
+		}
+	}
+
+	return res, nil
+}
+
+// objectLabel Returns the unique resource label made of the identity fields, name or comment.
+func objectLabel(typ string, d *schema.ResourceData, used map[string]struct{}) string {
+	keys := resourceIdentityFields[typ]
+	if len(keys) == 0 {
+		keys = []string{"name", "comment"}
+	}
+
+	var parts []string
+	for _, key := range keys {
+		if v, ok := d.Get(key).(string); ok && v != "" {
+			parts = append(parts, v)
+		}
+	}
+
+	label := strings.Trim(reLabelChars.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_"), "_")
+	if label == "" {
+		label = strings.TrimPrefix(typ, "routeros_")
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "_" + label
+	}
+
+	res := label
+	for i := 1; ; i++ {
+		if _, ok := used[res]; !ok {
+			used[res] = struct{}{}
+			return res
+		}
+		res = fmt.Sprintf("%v_%d", label, i)
+	}
+}
+
+// writeAttributes Writes the configurable attributes that differ from their defaults, the secrets are not
+// written.
+func writeAttributes(body *hclwrite.Body, s map[string]*schema.Schema, get func(string) interface{}) {
+	var names []string
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		attr := s[name]
+		if reMetadataFields.MatchString(name) || (attr.Computed && !attr.Optional) || attr.Sensitive ||
+			attr.WriteOnly || strings.HasSuffix(name, writeOnlyVersionSuffix) {
+			continue
+		}
+
+		v := get(name)
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+
+		if !attr.Required && isDefaultValue(attr, v) {
+			continue
+		}
+
+		if elem, ok := attr.Elem.(*schema.Resource); ok {
+			for _, e := range v.([]interface{}) {
+				m, _ := e.(map[string]interface{})
+				writeAttributes(body.AppendNewBlock(name, nil).Body(), elem.Schema, func(k string) interface{} {
+					return m[k]
+				})
+			}
+			continue
+		}
+
+		if value, ok := ctyValue(attr, v); ok {
+			body.SetAttributeValue(name, value)
+		}
+	}
+}
+
+func isDefaultValue(attr *schema.Schema, v interface{}) bool {
+	if attr.Default != nil {
+		return reflect.DeepEqual(attr.Default, v)
+	}
+
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
+
+func ctyValue(attr *schema.Schema, v interface{}) (cty.Value, bool) {
+	switch attr.Type {
+	case schema.TypeString:
+		s, ok := v.(string)
+		return cty.StringVal(s), ok
+	case schema.TypeInt:
+		i, ok := v.(int)
+		return cty.NumberIntVal(int64(i)), ok
+	case schema.TypeFloat:
+		f, ok := v.(float64)
+		return cty.NumberFloatVal(f), ok
+	case schema.TypeBool:
+		b, ok := v.(bool)
+		return cty.BoolVal(b), ok
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := attr.Elem.(*schema.Schema)
+		if !ok {
+			return cty.NilVal, false
+		}
+		var values []cty.Value
+		for _, e := range v.([]interface{}) {
+			value, ok := ctyValue(elem, e)
+			if !ok {
+				return cty.NilVal, false
+			}
+			values = append(values, value)
+		}
+		return cty.ListVal(values), true
+	case schema.TypeMap:
+		values := map[string]cty.Value{}
+		for k, e := range v.(map[string]interface{}) {
+			values[k] = cty.StringVal(fmt.Sprint(e))
+		}
+		return cty.MapVal(values), true
+	}
+	return cty.NilVal, false
+}
@@ -0,0 +1,87 @@
+package routeros
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testGenerateClient struct {
+	testOperationClient
+	items map[string][]MikrotikItem
+}
+
+func (c *testGenerateClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	var items []MikrotikItem
+	for _, item := range c.items[url.Path] {
+		if len(url.Query) > 0 && url.Query[0] == "?.id="+item[".id"] || len(url.Query) == 0 {
+			items = append(items, item)
+		}
+	}
+
+	switch r := result.(type) {
+	case *[]MikrotikItem:
+		*r = items
+	case *MikrotikItem:
+		for k, v := range items[0] {
+			(*r)[k] = v
+		}
+	}
+	return nil
+}
+
+func TestGenerateConfig(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"routeros_bridge":           ResourceInterfaceBridge(),
+		"routeros_interface_bridge": ResourceInterfaceBridge(),
+		"routeros_ip_dns":           ResourceDns(),
+	}
+	c := &testGenerateClient{items: map[string][]MikrotikItem{
+		"/interface/bridge": {
+			{".id": "*1", "name": "bridge1", "comment": "LAN", "vlan-filtering": "true"},
+			{".id": "*2", "name": "dynamic", "dynamic": "true"},
+		},
+		"/ip/dns": {
+			{"servers": "1.1.1.1", "allow-remote-requests": "true"},
+		},
+	}}
+
+	var b bytes.Buffer
+	if err := generateConfig(context.Background(), &b, resources, c, GenerateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"to = routeros_interface_bridge.bridge1",
+		`id = "*1"`,
+		`resource "routeros_interface_bridge" "bridge1" {`,
+		`comment        = "LAN"`,
+		`vlan_filtering = true`,
+		"to = routeros_ip_dns.ip_dns",
+		`id = "ip.dns"`,
+		`servers               = ["1.1.1.1"]`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("the generated configuration does not contain %q:\n%v", want, out)
+		}
+	}
+	for _, unwanted := range []string{"routeros_bridge.", "dynamic", "___"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("the generated configuration contains %q:\n%v", unwanted, out)
+		}
+	}
+
+	b.Reset()
+	if err := generateConfig(context.Background(), &b, resources, c, GenerateOptions{ImportsOnly: true,
+		Resources: []string{"routeros_interface_bridge"}}); err != nil {
+		t.Fatal(err)
+	}
+	if out = b.String(); strings.Contains(out, "resource ") || strings.Contains(out, "ip_dns") ||
+		!strings.Contains(out, "routeros_interface_bridge.bridge1") {
+		t.Errorf("wrong import blocks:\n%v", out)
+	}
+}
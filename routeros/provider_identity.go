@@ -0,0 +1,171 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resource identity (Terraform 1.12+): the objects can be imported by their natural keys instead of the
+// internal IDs:
+//
+//	import {
+//	  to       = routeros_interface_bridge.bridge
+//	  identity = { name = "bridge1" }
+//	}
+var resourceIdentityFields = map[string][]string{
+	"routeros_interface_bonding":        {"name"},
+	"routeros_interface_bridge":         {"name"},
+	"routeros_interface_bridge_port":    {"bridge", "interface"},
+	"routeros_interface_eoip":           {"name"},
+	"routeros_interface_ethernet":       {"name"},
+	"routeros_interface_gre":            {"name"},
+	"routeros_interface_list":           {"name"},
+	"routeros_interface_list_member":    {"list", "interface"},
+	"routeros_interface_macvlan":        {"name"},
+	"routeros_interface_pppoe_client":   {"name"},
+	"routeros_interface_veth":           {"name"},
+	"routeros_interface_vlan":           {"name"},
+	"routeros_interface_vrrp":           {"name"},
+	"routeros_interface_wireguard":      {"name"},
+	"routeros_interface_wireguard_peer": {"interface", "public_key"},
+	"routeros_ip_address":               {"address", "interface"},
+	"routeros_ip_dhcp_client":           {"interface"},
+	"routeros_ip_dhcp_server":           {"name"},
+	"routeros_ip_dhcp_server_network":   {"address"},
+	"routeros_ip_firewall_addr_list":    {"list", "address"},
+	"routeros_ip_firewall_filter":       {"chain", "comment"},
+	"routeros_ip_firewall_mangle":       {"chain", "comment"},
+	"routeros_ip_firewall_nat":          {"chain", "comment"},
+	"routeros_ip_firewall_raw":          {"chain", "comment"},
+	"routeros_ip_ipsec_peer":            {"name"},
+	"routeros_ip_ipsec_profile":         {"name"},
+	"routeros_ip_ipsec_proposal":        {"name"},
+	"routeros_ip_pool":                  {"name"},
+	"routeros_ipv6_address":             {"address", "interface"},
+	"routeros_ipv6_firewall_filter":     {"chain", "comment"},
+	"routeros_ipv6_firewall_mangle":     {"chain", "comment"},
+	"routeros_ipv6_firewall_nat":        {"chain", "comment"},
+	"routeros_ppp_profile":              {"name"},
+	"routeros_ppp_secret":               {"name"},
+	"routeros_routing_bgp_connection":   {"name"},
+	"routeros_routing_ospf_area":        {"name"},
+	"routeros_routing_ospf_instance":    {"name"},
+	"routeros_routing_table":            {"name"},
+	"routeros_snmp_community":           {"name"},
+	"routeros_system_scheduler":         {"name"},
+	"routeros_system_script":            {"name"},
+	"routeros_system_user":              {"name"},
+	"routeros_system_user_group":        {"name"},
+	"routeros_wifi":                     {"name"},
+	"routeros_wifi_configuration":       {"name"},
+	"routeros_wifi_security":            {"name"},
+}
+
+// addResourceIdentities Adds the identity schemas to the resources, the identity is set after every
+// create, read and update and is resolved into the internal ID during the import.
+func addResourceIdentities(p *schema.Provider) {
+	for name, keys := range resourceIdentityFields {
+		r, ok := p.ResourcesMap[name]
+		if !ok {
+			panic("[addResourceIdentities] unknown resource: " + name)
+		}
+
+		for _, key := range keys {
+			if attr, ok := r.Schema[key]; !ok || attr.Type != schema.TypeString {
+				panic(fmt.Sprintf("[addResourceIdentities] %v: '%v' is not a string attribute", name, key))
+			}
+		}
+
+		addResourceIdentity(r, keys)
+	}
+}
+
+func addResourceIdentity(r *schema.Resource, keys []string) {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := make(map[string]*schema.Schema, len(keys))
+			for _, key := range keys {
+				s[key] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       r.Schema[key].Description,
+				}
+			}
+			return s
+		},
+	}
+	// Names and comments can be changed in place.
+	r.ResourceBehavior.MutableIdentity = true
+
+	if r.CreateContext != nil {
+		r.CreateContext = withIdentity(keys, r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = withIdentity(keys, r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = withIdentity(keys, r.UpdateContext)
+	}
+
+	if r.Importer == nil || r.Importer.StateContext == nil {
+		return
+	}
+
+	importer := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			id, err := identityItemId(r.Schema, keys, d, m.(Client))
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+		}
+		return importer(ctx, d, m)
+	}
+}
+
+func withIdentity[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](keys []string, f F) F {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		identity, err := d.Identity()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		for _, key := range keys {
+			if err = identity.Set(key, d.Get(key).(string)); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		return diags
+	}
+}
+
+// identityItemId Returns the ID of the item found by the identity of the imported resource.
+func identityItemId(s map[string]*schema.Schema, keys []string, d *schema.ResourceData, c Client) (string, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return "", err
+	}
+
+	var filter []string
+	for _, key := range keys {
+		filter = append(filter, SnakeToKebab(key)+"="+identity.Get(key).(string))
+	}
+
+	meta := GetMetadata(s)
+	item, err := findItem(filter, meta.Path, c)
+	if err != nil {
+		return "", err
+	}
+
+	return item.GetID(meta.IdType), nil
+}
@@ -0,0 +1,78 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testIdentityClient struct {
+	testOperationClient
+	item  MikrotikItem
+	query []string
+}
+
+func (c *testIdentityClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	c.query = url.Query
+	*result.(*[]MikrotikItem) = []MikrotikItem{c.item}
+	return nil
+}
+
+func TestResourceIdentity(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			MetaResourcePath: PropResourcePath("/ip/firewall/filter"),
+			MetaId:           PropId(Id),
+			"chain":          {Type: schema.TypeString, Required: true},
+			"comment":        {Type: schema.TypeString, Optional: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			d.Set("chain", "input")
+			d.Set("comment", "ssh")
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(map[string]*schema.Schema{
+				MetaResourcePath: PropResourcePath("/ip/firewall/filter"),
+			}),
+		},
+	}
+	addResourceIdentity(r, []string{"chain", "comment"})
+
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaFunc(),
+		map[string]string{"chain": "input", "comment": "ssh"})
+	d.SetId("")
+
+	c := &testIdentityClient{item: MikrotikItem{".id": "*1A", "chain": "input", "comment": "ssh"}}
+	if _, err := r.Importer.StateContext(context.Background(), d, c); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "*1A" {
+		t.Errorf("imported ID = %q, want *1A", d.Id())
+	}
+	if want := []string{"chain=input", "comment=ssh"}; !reflect.DeepEqual(c.query, want) {
+		t.Errorf("filter = %v, want %v", c.query, want)
+	}
+
+	d = schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaFunc(), map[string]string{})
+	d.SetId("*1A")
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	identity, _ := d.Identity()
+	if identity.Get("chain") != "input" || identity.Get("comment") != "ssh" {
+		t.Errorf("identity is not set after the read: %v, %v", identity.Get("chain"), identity.Get("comment"))
+	}
+}
+
+func TestResourceIdentityFields(t *testing.T) {
+	p := Provider()
+	for name := range resourceIdentityFields {
+		if p.ResourcesMap[name].Identity == nil {
+			t.Errorf("%v: the identity schema is not set", name)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package routeros
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// KeyIgnoreChangesServer The attributes of the co-managed objects that are changed on the router (by a script or
+// by hand): they are neither sent to the router nor compared with the configuration.
+const KeyIgnoreChangesServer = "ignore_changes_server"
+
+// addIgnoreChangesServer Adds the 'ignore_changes_server' attribute to the resources that can be updated.
+func addIgnoreChangesServer(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		if _, ok := r.Schema[MetaResourcePath]; !ok || r.UpdateContext == nil {
+			continue
+		}
+		addSchemaIgnoreChangesServer(r.Schema)
+	}
+}
+
+func addSchemaIgnoreChangesServer(s map[string]*schema.Schema) {
+	var names []string
+	for name, attr := range s {
+		if !isIgnorableAttribute(name, attr) {
+			continue
+		}
+		names = append(names, name)
+
+		// The attributes are shared between the resources (PropCommentRw), the resource gets its own copy.
+		suppress := attr.DiffSuppressFunc
+		res := *attr
+		res.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			if name := strings.Split(k, ".")[0]; d.Get(KeyIgnoreChangesServer).(*schema.Set).Contains(name) {
+				return true
+			}
+			return suppress != nil && suppress(k, old, new, d)
+		}
+		s[name] = &res
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+
+	s[KeyIgnoreChangesServer] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(names, false),
+		},
+		Description: "Attributes of the object that are managed on the router, e.g. by a device-local script: " +
+			"they are neither sent to the router nor compared with the configuration.",
+	}
+}
+
+// isIgnorableAttribute Reports whether the attribute can be managed on the router: the configurable attributes
+// of the object without the nested blocks.
+func isIgnorableAttribute(name string, attr *schema.Schema) bool {
+	if reMetadataFields.MatchString(name) || (attr.Computed && !attr.Optional) || attr.WriteOnly {
+		return false
+	}
+
+	switch name {
+	case KeyAdoptExisting, KeyDestroyBehavior, KeyPlaceBefore, KeyPlaceAfter:
+		return false
+	}
+	if strings.HasSuffix(name, writeOnlyVersionSuffix) {
+		return false
+	}
+
+	_, nested := attr.Elem.(*schema.Resource)
+	return !nested
+}
+
+// loadIgnoredServerFields Returns the attributes of the resource that must not be sent to the router.
+func loadIgnoredServerFields(s map[string]*schema.Schema, d *schema.ResourceData) map[string]struct{} {
+	if _, ok := s[KeyIgnoreChangesServer]; !ok {
+		return nil
+	}
+
+	res := map[string]struct{}{}
+	for _, name := range d.Get(KeyIgnoreChangesServer).(*schema.Set).List() {
+		res[name.(string)] = struct{}{}
+	}
+	return res
+}
@@ -0,0 +1,107 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testIgnoreChangesServerResource() *schema.Resource {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/list"),
+		MetaId:           PropId(Id),
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		KeyComment: PropCommentRw,
+		"dynamic": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}}
+	addSchemaIgnoreChangesServer(r.Schema)
+	return r
+}
+
+func TestIgnoreChangesServerSchema(t *testing.T) {
+	r := testIgnoreChangesServerResource()
+
+	if r.Schema[KeyComment] == PropCommentRw || PropCommentRw.DiffSuppressFunc != nil {
+		t.Fatalf("the shared attribute is changed")
+	}
+	if r.Schema["dynamic"].DiffSuppressFunc != nil {
+		t.Errorf("the read-only attribute is suppressed")
+	}
+
+	validate := r.Schema[KeyIgnoreChangesServer].Elem.(*schema.Schema).ValidateFunc
+	if _, errs := validate("comment", KeyIgnoreChangesServer); len(errs) > 0 {
+		t.Errorf("comment: %v", errs)
+	}
+	if _, errs := validate("dynamic", KeyIgnoreChangesServer); len(errs) == 0 {
+		t.Errorf("the read-only attribute can be ignored")
+	}
+}
+
+func TestIgnoreChangesServer(t *testing.T) {
+	tests := []struct {
+		name     string
+		ignored  []string
+		wantDiff bool
+		wantItem bool
+	}{
+		{"Managed", nil, true, true},
+		{"Ignored", []string{KeyComment}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testIgnoreChangesServerResource()
+
+			var ignored []cty.Value
+			var ignoredList []interface{}
+			for _, name := range tt.ignored {
+				ignored = append(ignored, cty.StringVal(name))
+				ignoredList = append(ignoredList, name)
+			}
+			ignoredVal := cty.NullVal(cty.Set(cty.String))
+			if len(ignored) > 0 {
+				ignoredVal = cty.SetVal(ignored)
+			}
+
+			state := &terraform.InstanceState{ID: "*1", Attributes: map[string]string{"name": "list", "comment": "server"}}
+			config := map[string]cty.Value{}
+			for name, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+				config[name] = cty.NullVal(ty)
+			}
+			config["name"], config[KeyComment], config[KeyIgnoreChangesServer] =
+				cty.StringVal("list"), cty.StringVal("config"), ignoredVal
+
+			c := terraform.NewResourceConfigShimmed(cty.ObjectVal(config), r.CoreConfigSchema())
+			c.CtyValue = cty.ObjectVal(config)
+
+			diff, err := r.Diff(context.Background(), state, c, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hasDiff := diff != nil && diff.Attributes[KeyComment] != nil; hasDiff != tt.wantDiff {
+				t.Errorf("the comment diff = %v, want %v", diff, tt.wantDiff)
+			}
+
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"name":                 "list",
+				KeyComment:             "config",
+				KeyIgnoreChangesServer: ignoredList,
+			})
+			item, _ := TerraformResourceDataToMikrotik("", r.Schema, d)
+			if _, ok := item[KeyComment]; ok != tt.wantItem {
+				t.Errorf("the comment is sent = %v, want %v: %v", ok, tt.wantItem, item)
+			}
+			if _, ok := item[SnakeToKebab(KeyIgnoreChangesServer)]; ok {
+				t.Errorf("the provider option is sent: %v", item)
+			}
+		})
+	}
+}
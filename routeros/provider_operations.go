@@ -0,0 +1,146 @@
+package routeros
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider settings of the default operation timeouts.
+var defaultTimeoutKeys = map[string]string{
+	"create": "default_create_timeout",
+	"read":   "default_read_timeout",
+	"update": "default_update_timeout",
+	"delete": "default_delete_timeout",
+}
+
+// defaultOperationTimeout The SDK timeout of the operations that are not configured in the timeouts block.
+const defaultOperationTimeout = 20 * time.Minute
+
+// wrapOperations Wraps the CRUD functions of all resources and data sources: applies the provider
+// default timeouts, records tracing spans and passes the operation context to the client.
+// The resources without their own timeouts get the timeouts block of all operations.
+func wrapOperations(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		// Resources with their own timeouts are not affected by the provider defaults.
+		useDefaults := r.Timeouts == nil
+		if useDefaults {
+			r.Timeouts = operationTimeouts(r)
+		}
+
+		wrapResourceOperations(name, r, useDefaults)
+	}
+
+	for name, r := range p.DataSourcesMap {
+		wrapResourceOperations(name, r, true)
+	}
+}
+
+// operationTimeouts Returns the configurable timeouts of the resource operations:
+// timeouts { create = "10m" }
+func operationTimeouts(r *schema.Resource) *schema.ResourceTimeout {
+	t := &schema.ResourceTimeout{}
+
+	if r.CreateContext != nil {
+		t.Create = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.ReadContext != nil {
+		t.Read = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.UpdateContext != nil {
+		t.Update = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.DeleteContext != nil {
+		t.Delete = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+
+	return t
+}
+
+func wrapResourceOperations(name string, r *schema.Resource, useDefaults bool) {
+	if r.CreateContext != nil {
+		r.CreateContext = wrapOperation(name, "create", useDefaults, r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = wrapOperation(name, "read", useDefaults, r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = wrapOperation(name, "update", useDefaults, r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = wrapOperation(name, "delete", useDefaults, r.DeleteContext)
+	}
+}
+
+func wrapOperation[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](name,
+	operation string, useDefaults bool, f F) F {
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c, ok := m.(Client)
+		if !ok {
+			return f(ctx, d, m)
+		}
+
+		// The timeout of the resource configuration takes precedence over the provider default.
+		if extra := c.GetExtraParams(); useDefaults && extra != nil && d.Timeout(operation) == defaultOperationTimeout {
+			if timeout := extra.Timeouts[operation]; timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+		}
+
+		ctx, span := startOperationSpan(ctx, name, operation, d)
+
+		if sender, ok := m.(requestContextSender); ok {
+			m = &contextClient{Client: c, ctx: ctx, sender: sender}
+		}
+
+		start := time.Now()
+		diags := f(ctx, d, m)
+		endOperationSpan(span, diags)
+
+		if extra := c.GetExtraParams(); extra != nil && extra.Metrics != nil {
+			extra.Metrics.ObserveOperation(name, operation, time.Since(start))
+		}
+
+		return diags
+	}
+}
+
+// parseDefaultTimeouts Returns the provider default timeouts of CRUD operations.
+func parseDefaultTimeouts(d *schema.ResourceData) (map[string]time.Duration, error) {
+	var res = map[string]time.Duration{}
+
+	for operation, key := range defaultTimeoutKeys {
+		v := d.Get(key).(string)
+		if v == "" {
+			continue
+		}
+
+		timeout, err := ParseDuration(v, time.Second)
+		if err != nil {
+			return nil, err
+		}
+		res[operation] = timeout
+	}
+
+	return res, nil
+}
+
+type requestContextSender interface {
+	SendRequestContext(ctx context.Context, method crudMethod, url *URL, item MikrotikItem, result interface{}) error
+}
+
+// contextClient Passes the context of the Terraform operation to the client requests.
+type contextClient struct {
+	Client
+	ctx    context.Context
+	sender requestContextSender
+}
+
+func (c *contextClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return c.sender.SendRequestContext(c.ctx, method, url, item, result)
+}
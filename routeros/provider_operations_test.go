@@ -0,0 +1,91 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testOperationClient struct {
+	extra *ExtraParams
+	ctx   context.Context
+}
+
+func (c *testOperationClient) GetExtraParams() *ExtraParams { return c.extra }
+func (c *testOperationClient) GetTransport() TransportType  { return TransportREST }
+func (c *testOperationClient) GetRouterOSVersion() string   { return "" }
+func (c *testOperationClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return c.SendRequestContext(context.Background(), method, url, item, result)
+}
+func (c *testOperationClient) SendRequestContext(ctx context.Context, _ crudMethod, _ *URL, _ MikrotikItem, _ interface{}) error {
+	c.ctx = ctx
+	return nil
+}
+
+func TestWrapOperation(t *testing.T) {
+	tests := []struct {
+		name         string
+		useDefaults  bool
+		timeouts     map[string]time.Duration
+		configured   time.Duration
+		wantDeadline bool
+	}{
+		{"No default timeouts", true, nil, 0, false},
+		{"Default read timeout", true, map[string]time.Duration{"read": time.Minute}, 0, true},
+		{"Other operation timeout", true, map[string]time.Duration{"create": time.Minute}, 0, false},
+		{"Resource with own timeouts", false, map[string]time.Duration{"read": time.Minute}, 0, false},
+		{"Configured read timeout", true, map[string]time.Duration{"read": time.Minute}, 5 * time.Minute, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &testOperationClient{extra: &ExtraParams{Timeouts: tt.timeouts}}
+			read := wrapOperation("routeros_test", "read", tt.useDefaults,
+				schema.ReadContextFunc(func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+					return diag.FromErr(m.(Client).SendRequest(crudRead, &URL{Path: "/test"}, nil, nil))
+				}))
+
+			r := &schema.Resource{Schema: map[string]*schema.Schema{}, Timeouts: &schema.ResourceTimeout{
+				Read: schema.DefaultTimeout(defaultOperationTimeout),
+			}}
+			if tt.configured > 0 {
+				r.Timeouts.Read = schema.DefaultTimeout(tt.configured)
+			}
+
+			d := r.Data(nil)
+			if diags := read(context.Background(), d, c); diags.HasError() {
+				t.Fatalf("read() error = %v", diags)
+			}
+
+			if c.ctx == nil {
+				t.Fatalf("the operation context is not passed to the client")
+			}
+			if _, ok := c.ctx.Deadline(); ok != tt.wantDeadline {
+				t.Errorf("the operation context deadline = %v, want %v", ok, tt.wantDeadline)
+			}
+		})
+	}
+}
+
+func TestOperationTimeouts(t *testing.T) {
+	p := Provider()
+
+	r := p.ResourcesMap["routeros_ip_firewall_filter"]
+	if r.Timeouts == nil || r.Timeouts.Create == nil || r.Timeouts.Delete == nil ||
+		*r.Timeouts.Create != defaultOperationTimeout {
+		t.Errorf("the resource timeouts are not configurable: %#v", r.Timeouts)
+	}
+
+	// The own timeouts of the resource are kept.
+	if r := p.ResourcesMap["routeros_container"]; *r.Timeouts.Create == defaultOperationTimeout {
+		t.Errorf("the container timeouts are replaced: %v", *r.Timeouts.Create)
+	}
+
+	for name, r := range p.ResourcesMap {
+		if err := r.InternalValidate(nil, true); err != nil {
+			t.Errorf("%v: %v", name, err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -22,6 +23,7 @@ const (
 	MetaSkipFields     = "___skip___"
 	MetaSetUnsetFields = "___unset___"
 	MetaDropByValue    = "___drop_val___"
+	MetaMinVersion     = "___ros___"
 )
 
 const (
@@ -53,7 +55,9 @@ const (
 	KeyMacAddress              = "mac_address"
 	KeyMtu                     = "mtu"
 	KeyName                    = "name"
+	KeyPlaceAfter              = "place_after"
 	KeyPlaceBefore             = "place_before"
+	KeyQuery                   = "query"
 	KeyRemoteAddress           = "remote_address"
 	KeyRunning                 = "running"
 	KeyVrf                     = "vrf"
@@ -85,6 +89,49 @@ func PropId(t IdType) *schema.Schema {
 	}
 }
 
+// PropMinVersion The first RouterOS version of the resource.
+func PropMinVersion(ros string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     ros,
+		Description: "<em>The first RouterOS version of the resource. This is an internal service field, setting a value is not required.</em>",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+}
+
+// The note of the attribute description with the first RouterOS version of the attribute.
+const minVersionNote = "Requires RouterOS %v or later."
+
+var (
+	attributeMinVersionsMu sync.Mutex
+	// attributeMinVersions The first RouterOS versions of the attributes that are created by WithMinVersion.
+	// The version gates of the provider resources are taken from it by the attribute names.
+	attributeMinVersions = map[*schema.Schema]string{}
+)
+
+// WithMinVersion Returns the copy of the attribute with the first RouterOS version that has it.
+// The version is noted in the description, the plan of the configured attribute fails on the older routers.
+func WithMinVersion(ros string, s *schema.Schema) *schema.Schema {
+	res := *s
+	res.Description = strings.TrimSpace(res.Description + " " + fmt.Sprintf(minVersionNote, ros))
+
+	attributeMinVersionsMu.Lock()
+	defer attributeMinVersionsMu.Unlock()
+	attributeMinVersions[&res] = ros
+
+	return &res
+}
+
+// attributeMinVersion Returns the first RouterOS version of the attribute or an empty string.
+func attributeMinVersion(s *schema.Schema) string {
+	attributeMinVersionsMu.Lock()
+	defer attributeMinVersionsMu.Unlock()
+	return attributeMinVersions[s]
+}
+
 func toQuotedCommaSeparatedString(s ...string) string {
 	builder := strings.Builder{}
 	const singleQuote = `"`
@@ -440,13 +487,89 @@ var (
 `,
 	}
 	PropPlaceBefore = &schema.Schema{
-		Type:     schema.TypeString,
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{KeyPlaceAfter},
+		Description: "ID of the rule before which this rule is placed: `routeros_ip_firewall_filter.allow_ssh.id`. " +
+			"The rule is moved by the `move` command, the order is verified on read and the rule is moved back if " +
+			"it is located after the referenced rule. See [example](../data-sources/ip_firewall.md#example-usage).",
+	}
+	PropPlaceAfter = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{KeyPlaceBefore},
+		Description: "ID of the rule after which this rule is placed. The rule is moved by the `move` command, " +
+			"the order is verified on read and the rule is moved back if it is located before the referenced rule.",
+	}
+	PropQueryRw = &schema.Schema{
+		Type:     schema.TypeList,
 		Optional: true,
-		ForceNew: true,
-		Description: `Before which position the rule will be inserted.  
-	> Please check the effect of this option, as it does not work as you think!  
-	> Best way to use in conjunction with a data source. See [example](../data-sources/ip_firewall.md#example-usage).  
-`,
+		Description: "Conditions of the request, all of them must be met. The conditions are sent to the router " +
+			"as the query words of the print command, the regular expressions are matched by the provider.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Property name in the notation ```mac_address```.",
+				},
+				"equal": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The property is equal to the value.",
+				},
+				"not_equal": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The property is not equal to the value.",
+				},
+				"less": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The property is less than the value.",
+				},
+				"greater": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The property is greater than the value.",
+				},
+				"regex": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The property matches the regular expression.",
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+				"present": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "The property is present.",
+				},
+				"absent": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "The property is absent.",
+				},
+				"in": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The property is equal to one of the values.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"not": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Negate the conditions of the query.",
+				},
+				"or": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Join the query with the previous query by OR instead of AND: the queries " +
+						"```a```, ```b``` and ```c``` with ```or = true``` are ```a AND (b OR c)```.",
+				},
+			},
+		},
 	}
 	PropRemoteAddressRw = &schema.Schema{
 		Type:             schema.TypeString,
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestValidationDurationAtLeast(t *testing.T) {
@@ -183,3 +184,21 @@ func Test_toQuotedCommaSeparatedString(t *testing.T) {
 		})
 	}
 }
+
+func TestWithMinVersion(t *testing.T) {
+	s := WithMinVersion("7.15", &schema.Schema{Type: schema.TypeBool, Optional: true, Description: "Enables MVRP."})
+	if s.Description != "Enables MVRP. Requires RouterOS 7.15 or later." {
+		t.Errorf("Description = %q", s.Description)
+	}
+
+	// The version does not depend on the description text.
+	s.Description += " The description is changed by a wrapper."
+	if got := attributeMinVersion(s); got != "7.15" {
+		t.Errorf("attributeMinVersion() = %q, want 7.15", got)
+	}
+
+	plain := &schema.Schema{Type: schema.TypeBool, Optional: true, Description: "Requires RouterOS 7.15 or later."}
+	if got := attributeMinVersion(plain); got != "" {
+		t.Errorf("attributeMinVersion() = %q, the attribute is not created by WithMinVersion", got)
+	}
+}
@@ -0,0 +1,159 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ephemeralResource An ephemeral resource (Terraform 1.10+), its values are not stored in the plan or state.
+type ephemeralResource struct {
+	schema *tfprotov5.Schema
+	open   func(ctx context.Context, config tftypes.Value, m interface{}) (tftypes.Value, error)
+}
+
+var ephemeralResources = map[string]ephemeralResource{
+	"routeros_wireguard_keys": EphemeralWireguardKeys(),
+}
+
+// protocolServer The SDKv2 provider server extended with the provider-defined functions
+// and ephemeral resources, SDKv2 does not support them.
+type protocolServer struct {
+	*schema.GRPCProviderServer
+	provider *schema.Provider
+}
+
+// NewProtocolServer Returns the provider server for plugin.Serve.
+func NewProtocolServer() tfprotov5.ProviderServer {
+	p := Provider()
+	return &protocolServer{GRPCProviderServer: schema.NewGRPCProviderServer(p), provider: p}
+}
+
+func (s *protocolServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.GRPCProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	for name := range providerFunctions {
+		resp.Functions = append(resp.Functions, tfprotov5.FunctionMetadata{Name: name})
+	}
+
+	for name := range ephemeralResources {
+		resp.EphemeralResources = append(resp.EphemeralResources, tfprotov5.EphemeralResourceMetadata{TypeName: name})
+	}
+
+	return resp, nil
+}
+
+func (s *protocolServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.GRPCProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Functions = functionDefinitions()
+	for name, r := range ephemeralResources {
+		resp.EphemeralResourceSchemas[name] = r.schema
+	}
+
+	return resp, nil
+}
+
+func (s *protocolServer) GetFunctions(context.Context, *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{Functions: functionDefinitions()}, nil
+}
+
+func (s *protocolServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	f, ok := providerFunctions[req.Name]
+	if !ok {
+		return s.GRPCProviderServer.CallFunction(ctx, req)
+	}
+
+	if len(req.Arguments) != 1 {
+		return &tfprotov5.CallFunctionResponse{Error: &tfprotov5.FunctionError{
+			Text: fmt.Sprintf("%v expects one argument, got %v", req.Name, len(req.Arguments)),
+		}}, nil
+	}
+
+	var value string
+	v, err := req.Arguments[0].Unmarshal(tftypes.String)
+	if err == nil {
+		err = v.As(&value)
+	}
+
+	var res string
+	if err == nil {
+		res, err = f.call(value)
+	}
+
+	if err != nil {
+		var arg int64
+		return &tfprotov5.CallFunctionResponse{Error: &tfprotov5.FunctionError{
+			Text:             fmt.Sprintf("%v: %v", req.Name, err),
+			FunctionArgument: &arg,
+		}}, nil
+	}
+
+	result, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, res))
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.CallFunctionResponse{Result: &result}, nil
+}
+
+func (s *protocolServer) ValidateEphemeralResourceConfig(ctx context.Context, req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	if _, ok := ephemeralResources[req.TypeName]; !ok {
+		return s.GRPCProviderServer.ValidateEphemeralResourceConfig(ctx, req)
+	}
+
+	return &tfprotov5.ValidateEphemeralResourceConfigResponse{}, nil
+}
+
+func (s *protocolServer) OpenEphemeralResource(ctx context.Context, req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	r, ok := ephemeralResources[req.TypeName]
+	if !ok {
+		return s.GRPCProviderServer.OpenEphemeralResource(ctx, req)
+	}
+
+	config, err := req.Config.Unmarshal(r.schema.ValueType())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.open(ctx, config, s.provider.Meta())
+	if err != nil {
+		return &tfprotov5.OpenEphemeralResourceResponse{Diagnostics: []*tfprotov5.Diagnostic{{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to open " + req.TypeName,
+			Detail:   err.Error(),
+		}}}, nil
+	}
+
+	result, err := tfprotov5.NewDynamicValue(r.schema.ValueType(), res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.OpenEphemeralResourceResponse{Result: &result}, nil
+}
+
+func (s *protocolServer) RenewEphemeralResource(ctx context.Context, req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	if _, ok := ephemeralResources[req.TypeName]; !ok {
+		return s.GRPCProviderServer.RenewEphemeralResource(ctx, req)
+	}
+
+	return &tfprotov5.RenewEphemeralResourceResponse{}, nil
+}
+
+func (s *protocolServer) CloseEphemeralResource(ctx context.Context, req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	if _, ok := ephemeralResources[req.TypeName]; !ok {
+		return s.GRPCProviderServer.CloseEphemeralResource(ctx, req)
+	}
+
+	return &tfprotov5.CloseEphemeralResourceResponse{}, nil
+}
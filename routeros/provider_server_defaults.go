@@ -0,0 +1,60 @@
+package routeros
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addServerDefaultsSuppression Wraps the diff suppression of the optional attributes of all resources: if the
+// provider option 'ignore_server_defaults' is enabled, the values that the router returns for the attributes
+// that are not configured are not planned to be removed.
+func addServerDefaultsSuppression(p *schema.Provider) {
+	enabled := &atomic.Bool{}
+
+	configure := p.ConfigureContextFunc
+	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		enabled.Store(d.Get("ignore_server_defaults").(bool))
+		return configure(ctx, d)
+	}
+
+	for _, r := range p.ResourcesMap {
+		addSchemaDefaultsSuppression(r.Schema, enabled)
+	}
+}
+
+func addSchemaDefaultsSuppression(s map[string]*schema.Schema, enabled *atomic.Bool) {
+	for name, attr := range s {
+		if !isServerDefaultAttribute(name, attr) {
+			continue
+		}
+
+		// The attributes are shared between the resources (PropCommentRw), the resource gets its own copy.
+		suppress := attr.DiffSuppressFunc
+		res := *attr
+		res.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			if enabled.Load() && AlwaysPresentNotUserProvided(k, old, new, d) {
+				return true
+			}
+			return suppress != nil && suppress(k, old, new, d)
+		}
+		s[name] = &res
+	}
+}
+
+// isServerDefaultAttribute Reports whether the router may return its own value of the attribute: the optional
+// attributes of primitive types without the schema defaults.
+func isServerDefaultAttribute(name string, attr *schema.Schema) bool {
+	if reMetadataFields.MatchString(name) || !attr.Optional || attr.Computed || attr.WriteOnly ||
+		attr.Default != nil || attr.DefaultFunc != nil {
+		return false
+	}
+
+	switch attr.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeFloat, schema.TypeBool:
+		return true
+	}
+	return false
+}
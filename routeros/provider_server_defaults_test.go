@@ -0,0 +1,62 @@
+package routeros
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestServerDefaultsSuppression(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		comment  cty.Value
+		wantDiff bool
+	}{
+		{"Disabled", false, cty.NullVal(cty.String), true},
+		{"Not configured", true, cty.NullVal(cty.String), false},
+		{"Configured", true, cty.StringVal("new"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &schema.Resource{Schema: map[string]*schema.Schema{
+				MetaResourcePath: PropResourcePath("/interface/list"),
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				KeyComment: PropCommentRw,
+			}}
+
+			enabled := &atomic.Bool{}
+			enabled.Store(tt.enabled)
+			addSchemaDefaultsSuppression(r.Schema, enabled)
+
+			if r.Schema[KeyComment] == PropCommentRw || PropCommentRw.DiffSuppressFunc != nil {
+				t.Fatalf("the shared attribute is changed")
+			}
+
+			state := &terraform.InstanceState{ID: "*1", Attributes: map[string]string{"name": "list", "comment": "server"}}
+			config := map[string]cty.Value{}
+			for name, attr := range schema.InternalMap(r.Schema).CoreConfigSchema().Attributes {
+				config[name] = cty.NullVal(attr.Type)
+			}
+			config["name"], config[KeyComment] = cty.StringVal("list"), tt.comment
+
+			c := terraform.NewResourceConfigShimmed(cty.ObjectVal(config), schema.InternalMap(r.Schema).CoreConfigSchema())
+			c.CtyValue = cty.ObjectVal(config)
+
+			diff, err := r.Diff(context.Background(), state, c, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hasDiff := diff != nil && diff.Attributes[KeyComment] != nil; hasDiff != tt.wantDiff {
+				t.Errorf("the comment diff = %v, want %v", diff, tt.wantDiff)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package routeros
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -21,6 +23,9 @@ var testNames = []string{"API", "REST"}
 var reHost = regexp.MustCompile(`^(?:\S+://)?(\S+?)(?::\d+)*$`)
 var reVersion = regexp.MustCompile(`\d+`)
 
+// The RouterOS version of the test router (env: ROS_VERSION).
+var testRouterOSVersion string
+
 var providerConfig = `
 provider "routeros" {
 	insecure = true
@@ -40,11 +45,11 @@ func testCheckMinVersion(t *testing.T, version string) bool {
 	// version: 6.39.1
 	var current, min uint64
 
-	if RouterOSVersion == "" {
-		RouterOSVersion = os.Getenv("ROS_VERSION")
+	if testRouterOSVersion == "" {
+		testRouterOSVersion = os.Getenv("ROS_VERSION")
 	}
 
-	current, err := parseRouterOSVersion(RouterOSVersion)
+	current, err := parseRouterOSVersion(testRouterOSVersion)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -61,11 +66,11 @@ func testCheckMaxVersion(t *testing.T, version string) bool {
 	// version: 6.39.1
 	var current, max uint64
 
-	if RouterOSVersion == "" {
-		RouterOSVersion = os.Getenv("ROS_VERSION")
+	if testRouterOSVersion == "" {
+		testRouterOSVersion = os.Getenv("ROS_VERSION")
 	}
 
-	current, err := parseRouterOSVersion(RouterOSVersion)
+	current, err := parseRouterOSVersion(testRouterOSVersion)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -78,10 +83,32 @@ func testCheckMaxVersion(t *testing.T, version string) bool {
 	return current <= max
 }
 
+// testCheckRouterItems Returns true if the test router has the menu and, if the filter is set, the matching items:
+// the resources of the optional packages and of the hardware that the test router does not have are skipped.
+func testCheckRouterItems(t *testing.T, path string, filter ...string) bool {
+	// The acceptance tests are skipped by resource.Test.
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		return true
+	}
+
+	p := Provider()
+	if diags := p.Configure(context.Background(), sdkterraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	res, err := ReadItemsFiltered(filter, path, p.Meta().(Client))
+	if err != nil {
+		// RouterOS: no such command or directory.
+		return false
+	}
+
+	return len(filter) == 0 || len(*res) > 0
+}
+
 func TestCheckMinVersion(t *testing.T) {
-	originalVersion := RouterOSVersion
+	originalVersion := testRouterOSVersion
 	defer func() {
-		RouterOSVersion = originalVersion
+		testRouterOSVersion = originalVersion
 	}()
 
 	type args struct {
@@ -109,7 +136,7 @@ func TestCheckMinVersion(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			RouterOSVersion = tt.args.current
+			testRouterOSVersion = tt.args.current
 			if got := testCheckMinVersion(t, tt.args.min); got != tt.want {
 				t.Errorf("TestCheckMinVersion() diag got = %v, want = %v", got, tt.want)
 			}
@@ -164,11 +191,18 @@ func checkResourceSchema(s map[string]*schema.Schema, t *testing.T) {
 
 func testCheckResourceDestroy(resourcePath, resourceType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		cApi, _ := testAccProvider.Meta().(*ApiClient)
-		cRest, _ := testAccProvider.Meta().(*RestClient)
+		var m interface{} = testAccProvider.Meta()
+		if c, ok := m.(*lazyClient); ok {
+			if m, _ = c.getClient(); m == nil {
+				return fmt.Errorf("the provider is not connected to the router")
+			}
+		}
+
+		cApi, _ := m.(*ApiClient)
+		cRest, _ := m.(*RestClient)
 		var testTransport TransportType
 
-		switch testAccProvider.Meta().(type) {
+		switch m.(type) {
 		case *ApiClient:
 			testTransport = TransportAPI
 		case *RestClient:
@@ -0,0 +1,63 @@
+package routeros
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// MetaTransport The transport of the resource requests if it differs from the provider one,
+// e.g. the binary API for the long-running certificate signing.
+const MetaTransport = "___transport___"
+
+var transportOverrides = map[string]TransportType{
+	"api": TransportAPI,
+}
+
+// addTransportOverride Adds the '___transport___' attribute to the resources that can be updated.
+// It must be applied after wrapOperations: the provider client is replaced before the operation
+// wrappers pass the context to it.
+func addTransportOverride(p *schema.Provider) {
+	var names []string
+	for name := range transportOverrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, r := range p.ResourcesMap {
+		if _, ok := r.Schema[MetaResourcePath]; !ok || r.UpdateContext == nil {
+			continue
+		}
+
+		r.Schema[MetaTransport] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(names, false),
+			Description: "<em>The transport of the resource requests if it differs from the provider one. " +
+				"The connection is opened on the first request of such a resource.</em>",
+		}
+
+		r.CreateContext = overrideTransport(r.CreateContext)
+		r.ReadContext = overrideTransport(r.ReadContext)
+		r.UpdateContext = overrideTransport(r.UpdateContext)
+		r.DeleteContext = overrideTransport(r.DeleteContext)
+	}
+}
+
+func overrideTransport[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if c, ok := m.(*lazyClient); ok {
+			if transport, ok := transportOverrides[d.Get(MetaTransport).(string)]; ok {
+				m = c.withTransport(transport)
+			}
+		}
+		return f(ctx, d, m)
+	}
+}
@@ -0,0 +1,112 @@
+package routeros
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLazyClient_WithTransport(t *testing.T) {
+	var dials []string
+	c := &lazyClient{
+		ctx:       context.Background(),
+		transport: TransportREST,
+		hosts:     []*url.URL{{Scheme: "https", Host: "router.lan:8443"}, {Scheme: "https", Host: "[fe80::1]"}},
+		connect: func(*url.URL) (Client, diag.Diagnostics) {
+			t.Error("the provider transport is connected")
+			return nil, nil
+		},
+		connectTransport: func(transport TransportType, useTLS bool, u *url.URL) (Client, diag.Diagnostics) {
+			if transport != TransportAPI || !useTLS {
+				t.Errorf("connect(%v, %v), want API-SSL", transport, useTLS)
+			}
+			dials = append(dials, u.Host)
+			return &testOperationClient{}, nil
+		},
+	}
+
+	if client := c.withTransport(TransportREST); client != c {
+		t.Errorf("withTransport(REST) is not the provider client")
+	}
+
+	api := c.withTransport(TransportAPI)
+	if api == c || c.withTransport(TransportAPI) != api {
+		t.Fatalf("withTransport(API) is not the shared client of the transport")
+	}
+	if len(dials) != 0 {
+		t.Errorf("the client is connected before the first request: %v", dials)
+	}
+
+	if err := api.SendRequest(crudRead, &URL{Path: "/certificate"}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"router.lan:8729"}; !reflect.DeepEqual(dials, want) {
+		t.Errorf("connections = %v, want %v", dials, want)
+	}
+	if hosts := api.(*lazyClient).hosts; hosts[1].Host != "[fe80::1]:8729" {
+		t.Errorf("the failover host = %v", hosts[1])
+	}
+}
+
+func TestTransportOverride(t *testing.T) {
+	var used interface{}
+	op := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		used = m
+		return nil
+	}
+
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{
+		"routeros_certificate": {
+			Schema: map[string]*schema.Schema{
+				MetaResourcePath: PropResourcePath("/certificate"),
+				MetaId:           PropId(Id),
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+			CreateContext: op,
+			ReadContext:   op,
+			UpdateContext: op,
+			DeleteContext: op,
+		},
+	}}
+	addTransportOverride(p)
+	r := p.ResourcesMap["routeros_certificate"]
+
+	c := &lazyClient{
+		transport: TransportREST,
+		hosts:     []*url.URL{{Scheme: "https", Host: "router.lan"}},
+		connectTransport: func(TransportType, bool, *url.URL) (Client, diag.Diagnostics) {
+			return &testOperationClient{}, nil
+		},
+	}
+
+	tests := []struct {
+		name      string
+		transport string
+		want      TransportType
+	}{
+		{"Provider transport", "", TransportREST},
+		{"API", "api", TransportAPI},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "ca", MetaTransport: tt.transport})
+			if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if client, ok := used.(*lazyClient); !ok || client.transport != tt.want {
+				t.Errorf("the operation client = %v, want the %v transport", used, tt.want)
+			}
+		})
+	}
+
+	if _, errs := r.Schema[MetaTransport].ValidateFunc("rest", MetaTransport); len(errs) == 0 {
+		t.Errorf("the transport 'rest' is accepted")
+	}
+}
@@ -0,0 +1,109 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type versionGate struct {
+	ros string
+	// The empty attribute gates the whole resource.
+	attr string
+}
+
+// addVersionGates Adds the plan time check of the RouterOS version to the resources: the configuration that
+// uses a resource or an attribute the router does not have yet fails with the required version instead of
+// the 'unknown parameter' error of the apply. The versions are set in the schemas by PropMinVersion and
+// WithMinVersion, the gates are resolved by the attribute names before the attributes are copied.
+func addVersionGates(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if gates := resourceVersionGates(r.Schema); len(gates) > 0 {
+			r.CustomizeDiff = versionGatesCustomizeDiff(name, gates, r.CustomizeDiff)
+		}
+	}
+}
+
+// resourceVersionGates Returns the gate of the resource and the gates of its attributes.
+func resourceVersionGates(s map[string]*schema.Schema) []versionGate {
+	var res []versionGate
+	if attr, ok := s[MetaMinVersion]; ok {
+		res = append(res, versionGate{ros: attr.Default.(string)})
+	}
+
+	var names []string
+	for name, attr := range s {
+		if attributeMinVersion(attr) != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		res = append(res, versionGate{ros: attributeMinVersion(s[name]), attr: name})
+	}
+	return res
+}
+
+func versionGatesCustomizeDiff(name string, gates []versionGate,
+	customize schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if config := d.GetRawConfig(); !config.IsNull() {
+			configured := func(attr string) bool { return !config.GetAttr(attr).IsNull() }
+			if err := checkVersionGates(ctx, name, gates, configured, knownRouterOSVersion(m)); err != nil {
+				return err
+			}
+		}
+
+		if customize != nil {
+			return customize(ctx, d, m)
+		}
+		return nil
+	}
+}
+
+// knownRouterOSVersion Returns the version of the router without connecting to it: the configured version or
+// the version of the established connection. The plan does not need the router to be reachable.
+func knownRouterOSVersion(m interface{}) string {
+	if c, ok := m.(*lazyClient); ok {
+		return c.knownRouterOSVersion()
+	}
+	return routerOSVersion(m)
+}
+
+// checkVersionGates Returns the error of the first gate that is newer than the router. The check is skipped if
+// the version of the router is unknown.
+func checkVersionGates(ctx context.Context, name string, gates []versionGate, configured func(string) bool,
+	ros string) error {
+
+	if ros == "" {
+		return nil
+	}
+
+	version, err := parseRouterOSVersion(ros)
+	if err != nil {
+		ColorizedMessage(ctx, WARN, "RouterOS version gates are not checked", map[string]interface{}{"error": err})
+		return nil
+	}
+
+	for _, gate := range gates {
+		required, err := parseRouterOSVersion(gate.ros)
+		if err != nil || required <= version {
+			continue
+		}
+
+		if gate.attr == "" {
+			return fmt.Errorf("the resource '%v' requires RouterOS %v or later, the router runs %v", name,
+				gate.ros, ros)
+		}
+		if configured(gate.attr) {
+			return fmt.Errorf("the attribute '%v' of the resource '%v' requires RouterOS %v or later, "+
+				"the router runs %v", gate.attr, name, gate.ros, ros)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package routeros
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type testVersionClient struct {
+	testOperationClient
+	ros string
+}
+
+func (c *testVersionClient) GetRouterOSVersion() string { return c.ros }
+
+func TestResourceVersionGates(t *testing.T) {
+	tests := []struct {
+		resource *schema.Resource
+		want     []string
+	}{
+		{ResourceWifiSecurityMultiPassphrase(), []string{"7.17"}},
+		{ResourceInterfaceBridgePort(), []string{"7.15", "7.15"}},
+		{ResourceInterfaceBridge(), []string{"7.16", "7.16", "7.15"}},
+		{ResourceInterfaceList(), nil},
+	}
+	for _, tt := range tests {
+		path := tt.resource.Schema[MetaResourcePath].Default.(string)
+		t.Run(path, func(t *testing.T) {
+			var got []string
+			for _, gate := range resourceVersionGates(tt.resource.Schema) {
+				got = append(got, gate.ros)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("resourceVersionGates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionGatesCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		ros      string
+		config   map[string]cty.Value
+		wantErr  string
+	}{
+		{"Supported attribute", "routeros_interface_bridge", "7.15", map[string]cty.Value{"mvrp": cty.True}, ""},
+		{"Unsupported attribute", "routeros_interface_bridge", "7.14.3", map[string]cty.Value{"mvrp": cty.True},
+			"the attribute 'mvrp' of the resource 'routeros_interface_bridge' requires RouterOS 7.15 or later"},
+		{"Attribute not configured", "routeros_interface_bridge", "7.14.3", nil, ""},
+		{"Unknown version", "routeros_interface_bridge", "", map[string]cty.Value{"mvrp": cty.True}, ""},
+		{"Unsupported resource", "routeros_wifi_aaa", "7.12", nil,
+			"the resource 'routeros_wifi_aaa' requires RouterOS 7.13 or later, the router runs 7.12"},
+		{"Supported resource", "routeros_wifi_aaa", "7.13.1", nil, ""},
+	}
+	resources := Provider().ResourcesMap
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := resources[tt.resource]
+			s := r.CoreConfigSchema()
+
+			config := map[string]cty.Value{}
+			for name, ty := range s.ImpliedType().AttributeTypes() {
+				config[name] = cty.NullVal(ty)
+			}
+			config["name"] = cty.StringVal("test")
+			for name, v := range tt.config {
+				config[name] = v
+			}
+
+			// The same way as the plugin server passes the configuration of the new resource.
+			c := terraform.NewResourceConfigShimmed(cty.ObjectVal(config), s)
+			c.CtyValue = cty.ObjectVal(config)
+			state := &terraform.InstanceState{RawConfig: cty.ObjectVal(config)}
+
+			_, err := r.Diff(context.Background(), state, c, &testVersionClient{ros: tt.ros})
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("Diff() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKnownRouterOSVersion(t *testing.T) {
+	var connects int
+	c := &lazyClient{
+		ctx:   context.Background(),
+		hosts: []*url.URL{{Host: "router.lan"}},
+		connect: func(*url.URL) (Client, diag.Diagnostics) {
+			connects++
+			return &testVersionClient{ros: "7.16"}, nil
+		},
+	}
+
+	if got := knownRouterOSVersion(c); got != "" || connects != 0 {
+		t.Fatalf("knownRouterOSVersion() = %q, connects = %v, want no version and no connection", got, connects)
+	}
+
+	if _, err := c.getClient(); err != nil {
+		t.Fatal(err)
+	}
+	if got := knownRouterOSVersion(c); got != "7.16" || connects != 1 {
+		t.Fatalf("knownRouterOSVersion() = %q, connects = %v, want the version of the connection", got, connects)
+	}
+
+	c.version = "7.15"
+	if got := knownRouterOSVersion(c); got != "7.15" {
+		t.Fatalf("knownRouterOSVersion() = %q, want the configured version", got)
+	}
+}
@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Write-only attributes (Terraform 1.11+) of passwords and secrets: the value of 'password_wo' is sent
+// to the router as 'password', but it is stored neither in the plan nor in the state.
+// The value is sent again when 'password_wo_version' is changed.
+const (
+	writeOnlySuffix        = "_wo"
+	writeOnlyVersionSuffix = "_wo_version"
+)
+
+// addWriteOnlyAttributes Adds the write-only variants of the optional sensitive attributes of all resources.
+func addWriteOnlyAttributes(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		addWriteOnlySchema(r.Schema)
+	}
+}
+
+func addWriteOnlySchema(s map[string]*schema.Schema) {
+	var names []string
+	for name, attr := range s {
+		if attr.Type == schema.TypeString && attr.Sensitive && attr.Optional && !attr.ForceNew && !attr.WriteOnly &&
+			!reMetadataFields.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := s[name+writeOnlySuffix]; ok {
+			continue
+		}
+
+		s[name+writeOnlySuffix] = &schema.Schema{
+			Type:      schema.TypeString,
+			Optional:  true,
+			WriteOnly: true,
+			Sensitive: true,
+			Description: fmt.Sprintf("Write-only `%v`: the value is not stored in the state (Terraform 1.11+). "+
+				"Change `%v` to send a new value to the router.", name, name+writeOnlyVersionSuffix),
+			ValidateFunc:     s[name].ValidateFunc,
+			ValidateDiagFunc: s[name].ValidateDiagFunc,
+			ConflictsWith:    []string{name},
+			RequiredWith:     []string{name + writeOnlyVersionSuffix},
+		}
+		s[name+writeOnlyVersionSuffix] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  fmt.Sprintf("Version of `%v`, the value is sent to the router when it changes.", name+writeOnlySuffix),
+			RequiredWith: []string{name + writeOnlySuffix},
+		}
+	}
+}
+
+// isWriteOnlyValue Returns true if the attribute is set by its write-only variant,
+// so the value read from the router must not be stored in the state.
+func isWriteOnlyValue(name string, s map[string]*schema.Schema, d *schema.ResourceData) bool {
+	if strings.Contains(name, ".") {
+		return false
+	}
+
+	if _, ok := s[name+writeOnlyVersionSuffix]; !ok {
+		return false
+	}
+
+	return d.Get(name+writeOnlyVersionSuffix).(int) != 0
+}
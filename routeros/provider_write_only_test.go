@@ -0,0 +1,77 @@
+package routeros
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testWriteOnlySchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/ppp/secret"),
+		MetaId:           PropId(Id),
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+	addWriteOnlySchema(s)
+	return s
+}
+
+func TestAddWriteOnlySchema(t *testing.T) {
+	s := testWriteOnlySchema()
+
+	if !s["password_wo"].WriteOnly || s["password_wo_version"] == nil {
+		t.Fatalf("the write-only attributes are not added: %v", s)
+	}
+	if _, ok := s["name_wo"]; ok {
+		t.Errorf("the write-only attribute is added to the non-sensitive field")
+	}
+	if err := schema.InternalMap(s).InternalValidate(nil); err != nil {
+		t.Errorf("InternalValidate() error = %v", err)
+	}
+}
+
+func TestWriteOnlySerialization(t *testing.T) {
+	s := testWriteOnlySchema()
+
+	config := map[string]cty.Value{}
+	for name, attr := range schema.InternalMap(s).CoreConfigSchema().Attributes {
+		config[name] = cty.NullVal(attr.Type)
+	}
+	config["name"] = cty.StringVal("user")
+	config["password_wo"] = cty.StringVal("secret")
+	config["password_wo_version"] = cty.NumberIntVal(1)
+
+	d, err := schema.InternalMap(s).Data(nil, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"name":                {New: "user"},
+			"password_wo_version": {New: "1"},
+		},
+		RawConfig: cty.ObjectVal(config),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, _ := TerraformResourceDataToMikrotik("7.19", s, d)
+	if want := (MikrotikItem{"name": "user", "password": "secret"}); !reflect.DeepEqual(item, want) {
+		t.Errorf("TerraformResourceDataToMikrotik() = %v, want %v", item, want)
+	}
+
+	if diags := MikrotikResourceDataToTerraform("7.19", MikrotikItem{".id": "*1", "name": "user", "password": "secret"}, s, d); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if v := d.Get("password").(string); v != "" {
+		t.Errorf("the write-only value is stored in the state: %q", v)
+	}
+}
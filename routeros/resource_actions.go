@@ -34,6 +34,17 @@ func dynamicIdLookup(idType IdType, path string, c Client, d *schema.ResourceDat
 	return (*res)[0].GetID(Id), nil
 }
 
+// hasItemProperties Reports whether the response of the create contains the object (REST) and not only its ID,
+// so that the object does not have to be read again.
+func hasItemProperties(item MikrotikItem) bool {
+	for k := range item {
+		if k != ".id" && k != "ret" {
+			return true
+		}
+	}
+	return false
+}
+
 // Passing the called CRUD method on creation through an existing context.
 type ctxCrudMethod string
 
@@ -59,7 +70,8 @@ func ctxGetCrudMethod(ctx context.Context) crudMethod {
 //		return ResourceCreate(ctxSetCrudMethod(ctx, crudGenerateKey), resSchema, d, m)
 //	},
 func ResourceCreate(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	item, metadata := TerraformResourceDataToMikrotik(s, d)
+	item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), s, d)
+	addManagedComment(item, s, m)
 
 	res, err := CreateItem(ctx, item, metadata.Path, m.(Client))
 	if err != nil {
@@ -102,8 +114,8 @@ func ResourceCreate(ctx context.Context, s map[string]*schema.Schema, d *schema.
 		d.SetId(item.GetID(Name))
 	}
 
-	// We ask for information again in the case of API.
-	if m.(Client).GetTransport() == TransportAPI {
+	// We ask for information again if the response only contains the ID (API, SSH).
+	if !hasItemProperties(res) {
 		r, err := ReadItems(&ItemId{Id, res.GetID(Id)}, metadata.Path, m.(Client))
 		if err != nil {
 			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
@@ -124,11 +136,13 @@ func ResourceCreate(ctx context.Context, s map[string]*schema.Schema, d *schema.
 	}
 
 	//spew.Dump(res)
-	return MikrotikResourceDataToTerraform(res, s, d)
+	stripManagedComment(res, m)
+	return MikrotikResourceDataToTerraform(routerOSVersion(m), res, s, d)
 }
 
 func ResourceCreateAndWait(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
-	item, metadata := TerraformResourceDataToMikrotik(s, d)
+	item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), s, d)
+	addManagedComment(item, s, m)
 	if item[KeyName] == "" {
 		panic("Asynchronous resource creation should be applied to objects that have the 'name' attribute.")
 	}
@@ -209,8 +223,8 @@ func ResourceCreateAndWait(ctx context.Context, s map[string]*schema.Schema, d *
 		d.SetId(item.GetID(Name))
 	}
 
-	// We ask for information again in the case of API.
-	if m.(Client).GetTransport() == TransportAPI {
+	// We ask for information again if the response only contains the ID (API, SSH).
+	if !hasItemProperties(res) {
 		r, err := ReadItems(&ItemId{Id, res.GetID(Id)}, metadata.Path, m.(Client))
 		if err != nil {
 			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
@@ -231,7 +245,8 @@ func ResourceCreateAndWait(ctx context.Context, s map[string]*schema.Schema, d *
 	}
 
 	//spew.Dump(res)
-	return MikrotikResourceDataToTerraform(res, s, d)
+	stripManagedComment(res, m)
+	return MikrotikResourceDataToTerraform(routerOSVersion(m), res, s, d)
 }
 
 // ResourceRead Reading some information about one specific resource.
@@ -252,12 +267,33 @@ func ResourceRead(ctx context.Context, s map[string]*schema.Schema, d *schema.Re
 
 	d.SetId((*res)[0].GetID(metadata.IdType))
 
-	return MikrotikResourceDataToTerraform((*res)[0], s, d)
+	stripManagedComment((*res)[0], m)
+	return MikrotikResourceDataToTerraform(routerOSVersion(m), (*res)[0], s, d)
 }
 
 // ResourceUpdate Updating the resource in accordance with the TF Schema.
 func ResourceUpdate(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	item, metadata := TerraformResourceDataToMikrotik(s, d)
+	// Only the changed attributes are sent, the existing objects adopted on create get all of them.
+	if d.IsNewResource() {
+		item, _ := TerraformResourceDataToMikrotik(routerOSVersion(m), s, d)
+		addManagedComment(item, s, m)
+		return updateItem(ctx, s, d, m, item)
+	}
+
+	item, _ := TerraformResourceDataChangesToMikrotik(routerOSVersion(m), s, d)
+	// The unchanged comment is not sent and must not be replaced by the marker.
+	if _, ok := item[KeyComment]; ok {
+		addManagedComment(item, s, m)
+	}
+	if len(item) == 0 {
+		return ResourceRead(ctx, s, d, m)
+	}
+	return updateItem(ctx, s, d, m, item)
+}
+
+func updateItem(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{},
+	item MikrotikItem) diag.Diagnostics {
+	metadata := GetMetadata(s)
 
 	// d.Id() can be the name of a resource or its identifier.
 	// Mikrotik only operates on resource ID!
@@ -275,7 +311,8 @@ func ResourceUpdate(ctx context.Context, s map[string]*schema.Schema, d *schema.
 		return diag.FromErr(err)
 	}
 
-	return MikrotikResourceDataToTerraform(res, s, d)
+	stripManagedComment(res, m)
+	return MikrotikResourceDataToTerraform(routerOSVersion(m), res, s, d)
 }
 
 // ResourceDelete Deleting the resource.
@@ -322,12 +359,21 @@ func SystemResourceRead(ctx context.Context, s map[string]*schema.Schema, d *sch
 	// Id: /caps-man/manager -> caps-man.manager
 	d.SetId(strings.ReplaceAll(strings.TrimLeft(metadata.Path, "/"), "/", "."))
 
-	return MikrotikResourceDataToTerraform(res, s, d)
+	return MikrotikResourceDataToTerraform(routerOSVersion(m), res, s, d)
 }
 
 // SystemResourceCreateUpdate A resource cannot be created, it can only be changed.
 func SystemResourceCreateUpdate(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	item, metadata := TerraformResourceDataToMikrotik(s, d)
+	toMikrotik := TerraformResourceDataToMikrotik
+	// The update only sends the changed attributes.
+	if !d.IsNewResource() {
+		toMikrotik = TerraformResourceDataChangesToMikrotik
+	}
+
+	item, metadata := toMikrotik(routerOSVersion(m), s, d)
+	if len(item) == 0 && !d.IsNewResource() {
+		return SystemResourceRead(ctx, s, d, m)
+	}
 
 	var resUrl string
 	if m.(Client).GetTransport() == TransportREST {
@@ -361,7 +407,7 @@ func SystemResourceDelete(ctx context.Context, s map[string]*schema.Schema, d *s
 // Logic of `ID` processing
 // - The first character of the string contains an asterisk (standard Mikrotik identifier `*3E`): import without additional search.
 // - String containing no "=" character (`wifi-01`): the "name" field is used for searching.
-// - String containing only one "=" character (`"comment=hAP-ac3"`): the "word left" and "word right" pair is used for searching.
+// - String containing "=" characters (`"comment=hAP-ac3"`): the "word left" and "word right" pair (split by the first "=") is used for searching.
 func ImportStateCustomContext(s map[string]*schema.Schema) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		id := d.Id()
@@ -371,33 +417,48 @@ func ImportStateCustomContext(s map[string]*schema.Schema) schema.StateContextFu
 			return []*schema.ResourceData{d}, nil
 		} else {
 			// By default, we filter by the "name" field
-			if s := strings.Split(id, "="); len(s) == 2 {
+			if s := strings.SplitN(id, "=", 2); len(s) == 2 {
 				// field=value
 				fieldName = s[0]
 				id = s[1]
 			}
 		}
 
-		path := s[MetaResourcePath].Default.(string)
+		meta := GetMetadata(s)
+		if meta.IdType == 0 {
+			meta.IdType = Id
+		}
 
-		res, err := ReadItemsFiltered([]string{SnakeToKebab(fieldName) + "=" + id}, path, m.(Client))
+		item, err := findItem([]string{SnakeToKebab(fieldName) + "=" + id}, meta.Path, m.(Client))
 		if err != nil {
 			return nil, err
 		}
 
-		switch len(*res) {
-		case 0:
-			return nil, fmt.Errorf("resource not found: %v=%v", fieldName, id)
-		case 1:
-			retId, ok := (*res)[0][Id.String()]
-			if !ok {
-				return nil, fmt.Errorf("attribute %v not found in the response", Id.String())
-			}
-			d.SetId(retId)
-		default:
-			return nil, fmt.Errorf("more than one resource found: %v=%v", fieldName, id)
+		retId := item.GetID(meta.IdType)
+		if retId == "" {
+			return nil, fmt.Errorf("attribute %v not found in the response", meta.IdType)
 		}
+		d.SetId(retId)
 
 		return []*schema.ResourceData{d}, nil
 	}
 }
+
+// findItem Returns the only item that matches the filter: name=value.
+func findItem(filter []string, path string, c Client) (MikrotikItem, error) {
+	query := strings.Join(filter, ",")
+
+	res, err := ReadItemsFiltered(filter, path, c)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(*res) {
+	case 0:
+		return nil, fmt.Errorf("resource not found: %v", query)
+	case 1:
+		return (*res)[0], nil
+	default:
+		return nil, fmt.Errorf("more than one resource found: %v", query)
+	}
+}
@@ -69,7 +69,12 @@ func DefaultDelete(s map[string]*schema.Schema) schema.DeleteContextFunc {
 // ResourceInterfaceEthernetSwitch, ResourceInterfaceLte, ResourceIpService
 func DefaultCreateUpdate(s map[string]*schema.Schema) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		item, metadata := TerraformResourceDataToMikrotik(s, d)
+		toMikrotik := TerraformResourceDataToMikrotik
+		// The update only sends the changed attributes.
+		if !d.IsNewResource() {
+			toMikrotik = TerraformResourceDataChangesToMikrotik
+		}
+		item, metadata := toMikrotik(routerOSVersion(m), s, d)
 
 		res, err := ReadItems(&ItemId{Name, d.Get("name").(string)}, metadata.Path, m.(Client))
 		if err != nil {
@@ -85,6 +90,10 @@ func DefaultCreateUpdate(s map[string]*schema.Schema) func(context.Context, *sch
 			return diag.FromErr(errorNoLongerExists)
 		}
 
+		if len(item) == 0 && !d.IsNewResource() {
+			return ResourceRead(ctx, s, d, m)
+		}
+
 		d.SetId((*res)[0].GetID(Id))
 		item[".id"] = d.Id()
 
@@ -41,6 +41,25 @@ func DefaultSystemDatasourceRead(s map[string]*schema.Schema) schema.ReadContext
 			return diag.FromErr(err)
 		}
 
-		return MikrotikResourceDataToTerraformDatasource(&[]MikrotikItem{res}, "", s, d)
+		return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &[]MikrotikItem{res}, "", s, d)
+	}
+}
+
+// DefaultMonitorDatasourceRead Runs the 'monitor' command once for the interface set in the "interface" field.
+func DefaultMonitorDatasourceRead(s map[string]*schema.Schema) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		res := MikrotikItem{}
+		path := s[MetaResourcePath].Default.(string)
+		if m.(Client).GetTransport() == TransportREST {
+			path += "/monitor"
+		}
+
+		item := MikrotikItem{"numbers": d.Get("interface").(string), "once": ""}
+		err := m.(Client).SendRequest(crudMonitor, &URL{Path: path}, item, &res)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return MikrotikResourceDataToTerraformDatasource(routerOSVersion(m), &[]MikrotikItem{res}, "", s, d)
 	}
 }
@@ -0,0 +1,154 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Placement of the rules of the ordered menus (firewall, queues, access lists): 'place_before' and 'place_after'
+// reference another rule of the menu. The rule is moved by the 'move' command after create and update,
+// the order is verified on read, so that a rule moved outside Terraform is moved back.
+// Both fields must be listed in the MetaSkipFields of the resource.
+
+func DefaultPlacedCreate(s map[string]*schema.Schema) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := ResourceCreate(ctx, s, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if err := placeItem(s, d, m.(Client)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func DefaultPlacedRead(s map[string]*schema.Schema) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := ResourceRead(ctx, s, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if err := verifyPlacement(s, d, m.(Client)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func DefaultPlacedUpdate(s map[string]*schema.Schema) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := ResourceUpdate(ctx, s, d, m)
+		if diags.HasError() || !d.HasChanges(KeyPlaceBefore, KeyPlaceAfter) {
+			return diags
+		}
+
+		if err := placeItem(s, d, m.(Client)); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// placeItem Moves the rule before or after the referenced rule.
+func placeItem(s map[string]*schema.Schema, d *schema.ResourceData, c Client) error {
+	before, after := d.Get(KeyPlaceBefore).(string), d.Get(KeyPlaceAfter).(string)
+	if before == "" && after == "" {
+		return nil
+	}
+
+	path := s[MetaResourcePath].Default.(string)
+	ids, err := readItemIds(path, c)
+	if err != nil {
+		return err
+	}
+
+	self := slices.Index(ids, d.Id())
+	var destination string
+
+	if before != "" {
+		i := slices.Index(ids, before)
+		if i < 0 {
+			return fmt.Errorf("the rule '%v' of 'place_before' was not found", before)
+		}
+		if self >= 0 && self+1 == i {
+			return nil
+		}
+		destination = before
+	} else {
+		i := slices.Index(ids, after)
+		if i < 0 {
+			return fmt.Errorf("the rule '%v' of 'place_after' was not found", after)
+		}
+		if self >= 0 && self == i+1 {
+			return nil
+		}
+		// The rule is moved to the end of the list without the destination.
+		if next := i + 1; next < len(ids) {
+			destination = ids[next]
+		}
+	}
+
+	return moveItem(path, d.Id(), destination, c)
+}
+
+// moveItem Moves the item before the destination item or to the end of the list if the destination is empty.
+func moveItem(path, id, destination string, c Client) error {
+	item := MikrotikItem{"numbers": id}
+	if destination != "" {
+		item["destination"] = destination
+	}
+
+	if c.GetTransport() == TransportREST {
+		path += "/move"
+	}
+	return c.SendRequest(crudMove, &URL{Path: path}, item, nil)
+}
+
+// verifyPlacement Clears 'place_before' or 'place_after' if the rule is not located before or after
+// the referenced rule, so that the rule is moved again on the next apply.
+func verifyPlacement(s map[string]*schema.Schema, d *schema.ResourceData, c Client) error {
+	before, after := d.Get(KeyPlaceBefore).(string), d.Get(KeyPlaceAfter).(string)
+	if before == "" && after == "" {
+		return nil
+	}
+
+	ids, err := readItemIds(s[MetaResourcePath].Default.(string), c)
+	if err != nil {
+		return err
+	}
+	self := slices.Index(ids, d.Id())
+
+	if before != "" {
+		if i := slices.Index(ids, before); i < 0 || self > i {
+			return d.Set(KeyPlaceBefore, "")
+		}
+	}
+	if after != "" {
+		if i := slices.Index(ids, after); i < 0 || self < i {
+			return d.Set(KeyPlaceAfter, "")
+		}
+	}
+
+	return nil
+}
+
+// readItemIds Returns the IDs of the menu items in the list order.
+func readItemIds(path string, c Client) ([]string, error) {
+	items, err := ReadItems(nil, path, c, ".id")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(*items))
+	for _, item := range *items {
+		ids = append(ids, item.GetID(Id))
+	}
+	return ids, nil
+}
@@ -0,0 +1,94 @@
+package routeros
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testPlacementClient struct {
+	testOperationClient
+	ids  []string
+	move MikrotikItem
+}
+
+func (c *testPlacementClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudMove:
+		c.move = item
+	case crudRead:
+		res := result.(*[]MikrotikItem)
+		for _, id := range c.ids {
+			*res = append(*res, MikrotikItem{".id": id})
+		}
+	}
+	return nil
+}
+
+func TestPlaceItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		before   string
+		after    string
+		wantMove MikrotikItem
+		wantErr  bool
+	}{
+		{"Before", "*4", "*2", "", MikrotikItem{"numbers": "*4", "destination": "*2"}, false},
+		{"Already before", "*1", "*2", "", nil, false},
+		{"Located after", "*4", "*1", "", MikrotikItem{"numbers": "*4", "destination": "*1"}, false},
+		{"After the rule", "*4", "", "*1", MikrotikItem{"numbers": "*4", "destination": "*2"}, false},
+		{"After the last rule", "*1", "", "*4", MikrotikItem{"numbers": "*1"}, false},
+		{"Missing rule", "*1", "*9", "", nil, true},
+	}
+	s := ResourceIPFirewallFilter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := s.TestResourceData()
+			d.SetId(tt.id)
+			d.Set(KeyPlaceBefore, tt.before)
+			d.Set(KeyPlaceAfter, tt.after)
+
+			c := &testPlacementClient{ids: []string{"*1", "*2", "*3", "*4"}}
+			err := placeItem(s.Schema, d, c)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("placeItem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(c.move, tt.wantMove) {
+				t.Errorf("placeItem() move = %v, want %v", c.move, tt.wantMove)
+			}
+		})
+	}
+}
+
+func TestVerifyPlacement(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     string
+		after      string
+		wantBefore string
+		wantAfter  string
+	}{
+		{"Placed before", "*3", "", "*3", ""},
+		{"Moved after", "*1", "", "", ""},
+		{"Placed after", "", "*1", "", "*1"},
+		{"Moved before", "", "*3", "", ""},
+		{"Missing rule", "*9", "", "", ""},
+	}
+	s := ResourceIPFirewallFilter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := s.TestResourceData()
+			d.SetId("*2")
+			d.Set(KeyPlaceBefore, tt.before)
+			d.Set(KeyPlaceAfter, tt.after)
+
+			c := &testPlacementClient{ids: []string{"*1", "*2", "*3"}}
+			if err := verifyPlacement(s.Schema, d, c); err != nil {
+				t.Fatal(err)
+			}
+			if d.Get(KeyPlaceBefore) != tt.wantBefore || d.Get(KeyPlaceAfter) != tt.wantAfter {
+				t.Errorf("verifyPlacement() = %v, %v", d.Get(KeyPlaceBefore), d.Get(KeyPlaceAfter))
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package routeros
 
 import (
+	"context"
 	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"reflect"
 	"strconv"
@@ -73,3 +76,204 @@ func Test_defaultResourceCreateContext(t *testing.T) {
 		}
 	}
 }
+
+func Test_importStateCustomContext(t *testing.T) {
+	tests := []struct {
+		id        string
+		idType    IdType
+		wantQuery []string
+		wantId    string
+	}{
+		{"*1A", Id, nil, "*1A"},
+		{"bridge1", Id, []string{"name=bridge1"}, "*1A"},
+		{"comment=a=b", Id, []string{"comment=a=b"}, "*1A"},
+		{"mac_address=00:0C:29:00:01:A0", Id, []string{"mac-address=00:0C:29:00:01:A0"}, "*1A"},
+		{"comment=ssh", Name, []string{"comment=ssh"}, "ether1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			s := map[string]*schema.Schema{
+				MetaResourcePath: PropResourcePath("/interface"),
+				MetaId:           PropId(tt.idType),
+				"name":           {Type: schema.TypeString, Optional: true},
+			}
+			d := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+			d.SetId(tt.id)
+
+			c := &testIdentityClient{item: MikrotikItem{".id": "*1A", "name": "ether1"}}
+			if _, err := ImportStateCustomContext(s)(context.Background(), d, c); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(c.query, tt.wantQuery) || d.Id() != tt.wantId {
+				t.Errorf("query = %v, id = %v, want %v, %v", c.query, d.Id(), tt.wantQuery, tt.wantId)
+			}
+		})
+	}
+}
+
+func TestResourceUpdateChanges(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/interface/list"),
+		MetaId:           PropId(Id),
+		"name":           {Type: schema.TypeString, Required: true},
+		KeyComment:       {Type: schema.TypeString, Optional: true},
+		"mtu":            {Type: schema.TypeInt, Optional: true},
+	}}
+	state := &terraform.InstanceState{ID: "*1", Attributes: map[string]string{"name": "list", "comment": "old",
+		"mtu": "1500"}}
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		want   map[string]MikrotikItem
+	}{
+		{"Changed", map[string]interface{}{"name": "list", "comment": "new", "mtu": 1500},
+			map[string]MikrotikItem{"/interface/list/*1": {"comment": "new"}}},
+		{"Removed", map[string]interface{}{"name": "list", "mtu": 1500},
+			map[string]MikrotikItem{"/interface/list/*1": {"comment": ""}}},
+		{"Unchanged", map[string]interface{}{"name": "list", "comment": "old", "mtu": 1500},
+			map[string]MikrotikItem{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := schema.InternalMap(r.Schema).Data(state, diff)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c := &testDisableClient{items: []MikrotikItem{{".id": "*1"}}, updated: map[string]MikrotikItem{}}
+			if diags := ResourceUpdate(context.Background(), r.Schema, d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !reflect.DeepEqual(c.updated, tt.want) {
+				t.Errorf("updated = %v, want %v", c.updated, tt.want)
+			}
+		})
+	}
+}
+
+type testPostClient struct {
+	testOperationClient
+	posted []MikrotikItem
+}
+
+func (c *testPostClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudPost:
+		c.posted = append(c.posted, item)
+	case crudRead:
+		switch res := result.(type) {
+		case *MikrotikItem:
+			*res = MikrotikItem{"name": "router", "comment": "new", "mtu": "1500"}
+		case *[]MikrotikItem:
+			*res = []MikrotikItem{{".id": "*1", "name": "router", "comment": "new", "mtu": "1500"}}
+		}
+	}
+	return nil
+}
+
+func TestSystemResourceUpdateChanges(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/identity"),
+		MetaId:           PropId(Name),
+		"name":           {Type: schema.TypeString, Required: true},
+		KeyComment:       {Type: schema.TypeString, Optional: true},
+		"mtu":            {Type: schema.TypeInt, Optional: true},
+	}}
+	state := &terraform.InstanceState{ID: "*1", Attributes: map[string]string{"name": "router", "comment": "old",
+		"mtu": "1500"}}
+
+	tests := []struct {
+		name   string
+		update schema.UpdateContextFunc
+		config map[string]interface{}
+		want   []MikrotikItem
+	}{
+		{"System resource changed", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return SystemResourceCreateUpdate(ctx, r.Schema, d, m)
+		}, map[string]interface{}{"name": "router", "comment": "new", "mtu": 1500},
+			[]MikrotikItem{{"comment": "new"}}},
+		{"System resource unchanged", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return SystemResourceCreateUpdate(ctx, r.Schema, d, m)
+		}, map[string]interface{}{"name": "router", "comment": "old", "mtu": 1500}, nil},
+		{"Default resource changed", DefaultCreateUpdate(r.Schema),
+			map[string]interface{}{"name": "router", "comment": "new", "mtu": 1500},
+			[]MikrotikItem{{".id": "*1", "comment": "new"}}},
+		{"Default resource unchanged", DefaultCreateUpdate(r.Schema),
+			map[string]interface{}{"name": "router", "comment": "old", "mtu": 1500}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := schema.InternalMap(r.Schema).Data(state, diff)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c := &testPostClient{}
+			if diags := tt.update(context.Background(), d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !reflect.DeepEqual(c.posted, tt.want) {
+				t.Errorf("posted = %v, want %v", c.posted, tt.want)
+			}
+		})
+	}
+}
+
+type testCreateClient struct {
+	testOperationClient
+	created MikrotikItem
+	reads   int
+}
+
+func (c *testCreateClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudCreate:
+		*result.(*MikrotikItem) = c.created
+	case crudRead:
+		c.reads++
+		*result.(*[]MikrotikItem) = []MikrotikItem{{".id": "*1", "name": "list", "comment": "read"}}
+	}
+	return nil
+}
+
+func TestResourceCreateResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		created     MikrotikItem
+		wantReads   int
+		wantComment string
+	}{
+		{"Object", MikrotikItem{".id": "*1", "name": "list", "comment": "created"}, 0, "created"},
+		{"REST ID", MikrotikItem{".id": "*1"}, 1, "read"},
+		{"API ID", MikrotikItem{"ret": "*1"}, 1, "read"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &schema.Resource{Schema: map[string]*schema.Schema{
+				MetaResourcePath: PropResourcePath("/interface/list"),
+				MetaId:           PropId(Id),
+				"name":           {Type: schema.TypeString, Required: true},
+				KeyComment:       {Type: schema.TypeString, Optional: true},
+			}}
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "list", "comment": "config"})
+
+			c := &testCreateClient{created: tt.created}
+			if diags := ResourceCreate(context.Background(), r.Schema, d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if d.Id() != "*1" || c.reads != tt.wantReads || d.Get(KeyComment) != tt.wantComment {
+				t.Errorf("id = %v, reads = %v, comment = %v, want *1, %v, %v", d.Id(), c.reads, d.Get(KeyComment),
+					tt.wantReads, tt.wantComment)
+			}
+		})
+	}
+}
@@ -1,9 +1,6 @@
 package routeros
 
 import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -28,6 +25,7 @@ func ResourceCapsManAccessList() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/caps-man/access-list"),
 		MetaId:           PropId(Id),
+		MetaSkipFields:   PropSkipFields("place_before", "place_after"),
 
 		KeyComment:  PropCommentRw,
 		KeyDisabled: PropDisabledRw,
@@ -74,6 +72,7 @@ func ResourceCapsManAccessList() *schema.Resource {
 			Optional:    true,
 			Description: "Interface name to compare with an interface to which the client actually connects to.",
 		},
+		KeyPlaceAfter:  PropPlaceAfter,
 		KeyPlaceBefore: PropPlaceBefore,
 		"private_passphrase": {
 			Type:        schema.TypeString,
@@ -117,20 +116,13 @@ func ResourceCapsManAccessList() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		CreateContext: DefaultCreate(resSchema),
-		ReadContext:   DefaultRead(resSchema),
-		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			resSchema[MetaSkipFields].Default = `"place_before"`
-			defer func() {
-				resSchema[MetaSkipFields].Default = ``
-			}()
-
-			return ResourceUpdate(ctx, resSchema, d, m)
-		},
+		CreateContext: DefaultPlacedCreate(resSchema),
+		ReadContext:   DefaultPlacedRead(resSchema),
+		UpdateContext: DefaultPlacedUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
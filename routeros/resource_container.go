@@ -2,6 +2,7 @@ package routeros
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -27,6 +28,9 @@ import (
   }
 */
 
+var errContainerImage = errors.New("the container image could not be pulled or extracted, " +
+	"check the registry settings (routeros_container_config) and the 'container' topic of the RouterOS log")
+
 // https://help.mikrotik.com/docs/display/ROS/Container#Container-Properties
 func ResourceContainer() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
@@ -219,27 +223,36 @@ func ResourceContainer() *schema.Resource {
 			return diags
 		}
 
-		startContainer(ctx, resSchema, d, m)
+		if diags = startContainer(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
 
 		return ResourceRead(ctx, resSchema, d, m)
 	}
 
 	resUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		stopContainer(ctx, resSchema, d, m)
+		if diags := stopContainer(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
 
 		// Run DefaultUpdate.
 		diags := ResourceUpdate(ctx, resSchema, d, m)
 		if diags.HasError() {
 			return diags
 		}
-		startContainer(ctx, resSchema, d, m)
+
+		if diags = startContainer(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
 
 		return ResourceRead(ctx, resSchema, d, m)
 	}
 
 	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		// Stop container
-		stopContainer(ctx, resSchema, d, m)
+		if diags := stopContainer(ctx, resSchema, d, m); diags.HasError() {
+			return diags
+		}
 
 		// Run DefaultDelete.
 		return ResourceDelete(ctx, resSchema, d, m)
@@ -265,24 +278,14 @@ func ResourceContainer() *schema.Resource {
 }
 
 func startContainer(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	stopStateConf := &retry.StateChangeConf{
-		Pending: []string{"pulling", "extracting"},
-		Target:  []string{"stopped"},
-		Refresh: func() (result interface{}, state string, err error) {
-			metadata := GetMetadata(s)
-
-			res, err := ReadItems(&ItemId{metadata.IdType, d.Id()}, metadata.Path, m.(Client))
-			if err != nil {
-				return res, (*res)[0]["status"], err
+	if err := waitContainerImage(ctx, s, d, m); err != nil {
+		if d.IsNewResource() && errors.Is(err, errContainerImage) {
+			// Do not leave a container without an image on the device.
+			ColorizedMessage(ctx, WARN, "Removing the container instance "+d.Id()+": "+err.Error())
+			if diags := ResourceDelete(ctx, s, d, m); diags.HasError() {
+				return append(diag.FromErr(err), diags...)
 			}
-
-			return res, (*res)[0]["status"], nil
-		},
-		Timeout: d.Timeout(schema.TimeoutCreate),
-	}
-	_, err := stopStateConf.WaitForStateContext(ctx)
-	if err != nil {
-		err = fmt.Errorf("error waiting for container instance (%s) to be pulled: %s", d.Id(), err)
+		}
 		return diag.FromErr(err)
 	}
 
@@ -296,7 +299,7 @@ func startContainer(ctx context.Context, s map[string]*schema.Schema, d *schema.
 		resUrl.Path += "/start"
 	}
 
-	err = m.(Client).SendRequest(crudStart, resUrl, item, nil)
+	err := m.(Client).SendRequest(crudStart, resUrl, item, nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -304,16 +307,7 @@ func startContainer(ctx context.Context, s map[string]*schema.Schema, d *schema.
 	startStateConf := &retry.StateChangeConf{
 		Pending: []string{"stopped"},
 		Target:  []string{"running"},
-		Refresh: func() (result interface{}, state string, err error) {
-			metadata := GetMetadata(s)
-
-			res, err := ReadItems(&ItemId{metadata.IdType, d.Id()}, metadata.Path, m.(Client))
-			if err != nil {
-				return res, (*res)[0]["status"], err
-			}
-
-			return res, (*res)[0]["status"], nil
-		},
+		Refresh: containerStateRefreshFunc(s, d, m),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 	_, err = startStateConf.WaitForStateContext(ctx)
@@ -326,33 +320,50 @@ func startContainer(ctx context.Context, s map[string]*schema.Schema, d *schema.
 }
 
 func stopContainer(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	item := MikrotikItem{"number": d.Id()}
-
-	var resUrl = &URL{
-		Path: s[MetaResourcePath].Default.(string),
-	}
-	if m.(Client).GetTransport() == TransportREST {
-		resUrl.Path += "/stop"
-	}
-
-	err := m.(Client).SendRequest(crudStop, resUrl, item, nil)
+	_, status, err := containerStateRefreshFunc(s, d, m)()
 	if err != nil {
+		// The container was removed outside of Terraform, there is nothing to stop.
+		if err == errorNoLongerExists {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
+	// The stop command is only applicable to a running container.
+	switch status {
+	case "running":
+		item := MikrotikItem{"number": d.Id()}
+
+		var resUrl = &URL{
+			Path: s[MetaResourcePath].Default.(string),
+		}
+		if m.(Client).GetTransport() == TransportREST {
+			resUrl.Path += "/stop"
+		}
+
+		err = m.(Client).SendRequest(crudStop, resUrl, item, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	case "stopping":
+		// The container is already being stopped, wait for it.
+	case "pulling", "extracting":
+		// The container is not started until the image is extracted. The image error does not prevent the
+		// container from being updated or removed.
+		if err = waitContainerImage(ctx, s, d, m); err != nil && !errors.Is(err, errContainerImage) {
+			return diag.FromErr(err)
+		}
+		return nil
+	case "stopped", "error":
+		return nil
+	default:
+		return diag.Errorf("container instance (%s) cannot be stopped in the '%s' status", d.Id(), status)
+	}
+
 	stopStateConf := &retry.StateChangeConf{
 		Pending: []string{"stopping"},
 		Target:  []string{"stopped"},
-		Refresh: func() (result interface{}, state string, err error) {
-			metadata := GetMetadata(s)
-
-			res, err := ReadItems(&ItemId{metadata.IdType, d.Id()}, metadata.Path, m.(Client))
-			if err != nil {
-				return res, (*res)[0]["status"], err
-			}
-
-			return res, (*res)[0]["status"], nil
-		},
+		Refresh: containerStateRefreshFunc(s, d, m),
 		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
 	_, err = stopStateConf.WaitForStateContext(ctx)
@@ -362,3 +373,42 @@ func stopContainer(ctx context.Context, s map[string]*schema.Schema, d *schema.R
 	}
 	return nil
 }
+
+// waitContainerImage Waits for the container image to be pulled and extracted.
+// RouterOS sets the 'error' status if the image cannot be downloaded or unpacked.
+func waitContainerImage(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) error {
+	pullStateConf := &retry.StateChangeConf{
+		Pending: []string{"pulling", "extracting"},
+		Target:  []string{"stopped", "error"},
+		Refresh: containerStateRefreshFunc(s, d, m),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+	res, err := pullStateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for container instance (%s) to be pulled: %s", d.Id(), err)
+	}
+
+	if (*res.(*[]MikrotikItem))[0]["status"] == "error" {
+		return fmt.Errorf("container instance (%s): %w", d.Id(), errContainerImage)
+	}
+
+	return nil
+}
+
+// containerStateRefreshFunc Returns the current container status for the state change waiters.
+func containerStateRefreshFunc(s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) retry.StateRefreshFunc {
+	return func() (result interface{}, state string, err error) {
+		metadata := GetMetadata(s)
+
+		res, err := ReadItems(&ItemId{metadata.IdType, d.Id()}, metadata.Path, m.(Client))
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(*res) == 0 {
+			return nil, "", errorNoLongerExists
+		}
+
+		return res, (*res)[0]["status"], nil
+	}
+}
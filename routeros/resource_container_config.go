@@ -15,17 +15,17 @@ func ResourceContainerConfig() *schema.Resource {
 			Optional:    true,
 			Description: "External registry url from where the container will be downloaded.",
 		},
-		"username": {
+		"username": WithMinVersion("7.8", &schema.Schema{
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "Specifies the username for authentication (starting from ROS 7.8)",
-		},
-		"password": {
+			Description: "Specifies the username for authentication.",
+		}),
+		"password": WithMinVersion("7.8", &schema.Schema{
 			Type:        schema.TypeString,
 			Sensitive:   true,
 			Optional:    true,
-			Description: "Specifies the password for authentication (starting from ROS 7.8)",
-		},
+			Description: "Specifies the password for authentication.",
+		}),
 		"ram_high": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -0,0 +1,59 @@
+package routeros
+
+import (
+	"context"
+	"testing"
+)
+
+type testContainerClient struct {
+	testOperationClient
+	statuses []string
+	stopped  int
+}
+
+func (c *testContainerClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudRead:
+		status := c.statuses[0]
+		if len(c.statuses) > 1 {
+			c.statuses = c.statuses[1:]
+		}
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), MikrotikItem{".id": "*1", "status": status})
+	case crudStop:
+		c.stopped++
+	}
+	return nil
+}
+
+func TestStopContainer(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		stopped  int
+		wantErr  bool
+	}{
+		{"Running", []string{"running", "stopping", "stopped"}, 1, false},
+		{"Stopping", []string{"stopping", "stopped"}, 0, false},
+		{"Extracting", []string{"extracting", "stopped"}, 0, false},
+		{"Image error", []string{"extracting", "error"}, 0, false},
+		{"Stopped", []string{"stopped"}, 0, false},
+		{"Error", []string{"error"}, 0, false},
+		{"Unknown", []string{"starting"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ResourceContainer()
+			d := r.TestResourceData()
+			d.SetId("*1")
+
+			c := &testContainerClient{statuses: tt.statuses}
+			diags := stopContainer(context.Background(), r.Schema, d, c)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("stopContainer() = %v, want error %v", diags, tt.wantErr)
+			}
+			if c.stopped != tt.stopped {
+				t.Errorf("stop requests = %v, want %v", c.stopped, tt.stopped)
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIPFirewallFilterChain https://help.mikrotik.com/docs/display/ROS/Filter
+func ResourceIPFirewallFilterChain() *schema.Resource {
+	return resourceFirewallChain(ResourceIPFirewallFilter(), "routeros_ip_firewall_filter")
+}
+
+// ResourceIPFirewallNatChain https://help.mikrotik.com/docs/display/ROS/NAT
+func ResourceIPFirewallNatChain() *schema.Resource {
+	return resourceFirewallChain(ResourceIPFirewallNat(), "routeros_ip_firewall_nat")
+}
+
+// resourceFirewallChain Manages all rules of a chain as an ordered list. The rules of the router are matched
+// with the list by their properties: the unmatched rules are removed, the missing rules are added and only the
+// rules out of order are moved.
+func resourceFirewallChain(rule *schema.Resource, ruleName string) *schema.Resource {
+	path := rule.Schema[MetaResourcePath].Default.(string)
+	ruleSchema := chainRuleSchema(rule.Schema)
+
+	resRead := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		items, err := readChainRules(path, d.Id(), m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return diag.FromErr(err)
+		}
+
+		rules := make([]map[string]interface{}, 0, len(items))
+		for _, item := range items {
+			rules = append(rules, chainRuleValues(ruleSchema, item))
+		}
+
+		if err = d.Set("chain", d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("rule", rules); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
+	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		chain := d.Get("chain").(string)
+
+		var want []MikrotikItem
+		for _, v := range d.Get("rule").([]interface{}) {
+			rule, _ := v.(map[string]interface{})
+			want = append(want, chainRuleItem(ruleSchema, rule))
+		}
+
+		if err := syncChain(ctx, path, chain, ruleSchema, want, m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId(chain)
+		return resRead(ctx, d, m)
+	}
+
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		items, err := readChainRules(path, d.Id(), m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+			return diag.FromErr(err)
+		}
+
+		for _, item := range items {
+			if err = DeleteItem(&ItemId{Id, item.GetID(Id)}, path, m.(Client)); err != nil {
+				ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+				return diag.FromErr(err)
+			}
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		Description: fmt.Sprintf("*<span style=\"color:#3d85c6\">Authoritative resource: all rules of the "+
+			"chain are managed by it.</span>*\nThe rules of the ```%v``` chain in the order of processing. The rules "+
+			"that are not in the list are removed, so the rules of the chain must not be managed by the "+
+			"```%v``` resource at the same time.", path, ruleName),
+		CreateContext: resCreateUpdate,
+		ReadContext:   resRead,
+		UpdateContext: resCreateUpdate,
+		DeleteContext: resDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"chain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the chain: the built-in chain or the chain of the jump rules.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of the chain in the order of processing.",
+				Elem: &schema.Resource{
+					Schema: ruleSchema,
+				},
+			},
+		},
+	}
+}
+
+// chainRuleSchema Returns the rule attributes of the rule resource schema without the metadata, the chain, the
+// placement and the read-only attributes.
+func chainRuleSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	res := map[string]*schema.Schema{}
+	for k, v := range s {
+		if reMetadataFields.MatchString(k) || k == "chain" || k == KeyPlaceBefore || k == KeyPlaceAfter ||
+			(v.Computed && !v.Optional) {
+			continue
+		}
+
+		// The references between the attributes do not work inside the list.
+		attr := *v
+		attr.ForceNew = false
+		attr.ConflictsWith, attr.RequiredWith, attr.ExactlyOneOf, attr.AtLeastOneOf = nil, nil, nil, nil
+		res[k] = &attr
+	}
+	return res
+}
+
+// chainRuleItem Returns the rule in the RouterOS notation, the empty attributes are not included.
+func chainRuleItem(s map[string]*schema.Schema, rule map[string]interface{}) MikrotikItem {
+	item := MikrotikItem{}
+	for k, attr := range s {
+		name := SnakeToKebab(k)
+
+		switch v := rule[k].(type) {
+		case string:
+			if v != "" || attr.Default != nil {
+				item[name] = v
+			}
+		case int:
+			if v != 0 || attr.Default != nil {
+				item[name] = strconv.Itoa(v)
+			}
+		case bool:
+			if v || attr.Default != nil {
+				item[name] = BoolToMikrotikJSON(v)
+			}
+		case nil:
+		default:
+			panic(fmt.Sprintf("[chainRuleItem] type not implemented: %T for '%v'", v, k))
+		}
+	}
+	return item
+}
+
+// chainRuleValues Returns the rule attributes of the RouterOS rule.
+func chainRuleValues(s map[string]*schema.Schema, item MikrotikItem) map[string]interface{} {
+	rule := map[string]interface{}{}
+	for k, attr := range s {
+		v, ok := item[SnakeToKebab(k)]
+
+		switch attr.Type {
+		case schema.TypeString:
+			rule[k] = v
+		case schema.TypeInt:
+			i, _ := strconv.Atoi(v)
+			rule[k] = i
+		case schema.TypeBool:
+			rule[k] = ok && BoolFromMikrotikJSON(v)
+		default:
+			panic(fmt.Sprintf("[chainRuleValues] type not implemented: %v for '%v'", attr.Type, k))
+		}
+	}
+	return rule
+}
+
+// readChainRules Reads the rules of the chain in the order of processing, the dynamic rules are skipped.
+func readChainRules(path, chain string, c Client) ([]MikrotikItem, error) {
+	items, err := ReadItemsFiltered([]string{"chain=" + chain}, path, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []MikrotikItem
+	for _, item := range *items {
+		if item["dynamic"] == "true" {
+			continue
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// syncChain Removes the router rules that do not match any of the wanted rules, adds the missing rules and moves
+// the rules into the wanted order.
+func syncChain(ctx context.Context, path, chain string, s map[string]*schema.Schema, want []MikrotikItem,
+	c Client) error {
+	existing, err := readChainRules(path, chain, c)
+	if err != nil {
+		return err
+	}
+
+	// The router values are normalized in the same way as the wanted rules: 'true' and 'yes', '0' and unset.
+	ids := make([]string, len(want))
+	used := make([]bool, len(existing))
+	for i, w := range want {
+		for j, e := range existing {
+			if !used[j] && reflect.DeepEqual(w, chainRuleItem(s, chainRuleValues(s, e))) {
+				ids[i], used[j] = e.GetID(Id), true
+				break
+			}
+		}
+	}
+
+	for j, e := range existing {
+		if !used[j] {
+			if err = DeleteItem(&ItemId{Id, e.GetID(Id)}, path, c); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, w := range want {
+		if ids[i] != "" {
+			continue
+		}
+
+		item := MikrotikItem{"chain": chain}
+		for k, v := range w {
+			item[k] = v
+		}
+
+		res, err := CreateItem(ctx, item, path, c)
+		if err != nil {
+			return err
+		}
+		if ids[i] = res.GetID(Id); ids[i] == "" {
+			return fmt.Errorf("the ID of the rule #%d was not found in the response", i)
+		}
+	}
+
+	order, err := readItemIds(path, c)
+	if err != nil {
+		return err
+	}
+	order = slices.DeleteFunc(order, func(id string) bool { return !slices.Contains(ids, id) })
+
+	for _, move := range chainMoves(ids, order) {
+		if err = moveItem(path, move[0], move[1], c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chainMoves Returns the minimal moves {id, destination} that put the current rules into the wanted order:
+// the longest sequence of the rules that are already in order stays in place and the other rules are moved
+// before their successor, starting from the end of the chain.
+func chainMoves(want, current []string) [][2]string {
+	pos := make(map[string]int, len(current))
+	for i, id := range current {
+		pos[id] = i
+	}
+
+	// The longest increasing subsequence of the current positions.
+	// tails[k] is the index of the smallest tail of the subsequences of length k+1.
+	var tails []int
+	prev := make([]int, len(want))
+	for i, id := range want {
+		k, _ := slices.BinarySearchFunc(tails, pos[id], func(t, p int) int { return pos[want[t]] - p })
+		if k > 0 {
+			prev[i] = tails[k-1]
+		} else {
+			prev[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	keep := make([]bool, len(want))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			keep[i] = true
+		}
+	}
+
+	var res [][2]string
+	for i := len(want) - 1; i >= 0; i-- {
+		if keep[i] {
+			continue
+		}
+
+		// The last rule is moved to the end of the list.
+		var destination string
+		if i+1 < len(want) {
+			destination = want[i+1]
+		}
+		res = append(res, [2]string{want[i], destination})
+	}
+
+	return res
+}
@@ -0,0 +1,151 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testIPFirewallFilterChainAddress = "routeros_ip_firewall_filter_chain.test"
+
+func TestAccIPFirewallFilterChainTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIPFirewallFilterChainConfig(`"10.0.0.1", "10.0.0.2", "10.0.0.3"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPFirewallFilterChainAddress, "id", "tf-test"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterChainAddress, "rule.#", "3"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterChainAddress, "rule.0.src_address", "10.0.0.1"),
+						),
+					},
+					{
+						Config: testAccIPFirewallFilterChainConfig(`"10.0.0.3", "10.0.0.1", "10.0.0.4"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPFirewallFilterChainAddress, "rule.#", "3"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterChainAddress, "rule.0.src_address", "10.0.0.3"),
+							resource.TestCheckResourceAttr(testIPFirewallFilterChainAddress, "rule.2.src_address", "10.0.0.4"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccIPFirewallFilterChainConfig(addresses string) string {
+	return providerConfig + `
+
+resource "routeros_ip_firewall_filter_chain" "test" {
+	chain = "tf-test"
+
+	dynamic "rule" {
+		for_each = [` + addresses + `]
+		content {
+			action      = "accept"
+			src_address = rule.value
+		}
+	}
+}
+`
+}
+
+func TestChainMoves(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    []string
+		current []string
+		moves   [][2]string
+	}{
+		{"In order", []string{"*1", "*2", "*3"}, []string{"*1", "*2", "*3"}, nil},
+		{"First to last", []string{"*2", "*3", "*1"}, []string{"*1", "*2", "*3"}, [][2]string{{"*1", ""}}},
+		{"Last to first", []string{"*3", "*1", "*2"}, []string{"*1", "*2", "*3"}, [][2]string{{"*3", "*1"}}},
+		{"Reversed", []string{"*3", "*2", "*1"}, []string{"*1", "*2", "*3"},
+			[][2]string{{"*2", "*1"}, {"*3", "*2"}}},
+		{"Swap", []string{"*1", "*3", "*2", "*4"}, []string{"*1", "*2", "*3", "*4"}, [][2]string{{"*3", "*2"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves := chainMoves(tt.want, tt.current)
+			if !reflect.DeepEqual(moves, tt.moves) {
+				t.Errorf("chainMoves() = %v, want %v", moves, tt.moves)
+			}
+
+			// Apply the moves.
+			order := append([]string{}, tt.current...)
+			for _, move := range moves {
+				for i, id := range order {
+					if id == move[0] {
+						order = append(order[:i], order[i+1:]...)
+						break
+					}
+				}
+				i := len(order)
+				for j, id := range order {
+					if id == move[1] {
+						i = j
+					}
+				}
+				order = append(order[:i], append([]string{move[0]}, order[i:]...)...)
+			}
+			if !reflect.DeepEqual(order, tt.want) {
+				t.Errorf("the moved rules = %v, want %v", order, tt.want)
+			}
+		})
+	}
+}
+
+type testChainClient struct {
+	testOperationClient
+	items   []MikrotikItem
+	created []MikrotikItem
+	deleted []string
+}
+
+func (c *testChainClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudRead:
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	case crudCreate:
+		c.created = append(c.created, item)
+		*result.(*MikrotikItem) = MikrotikItem{".id": "*new"}
+		c.items = append(c.items, MikrotikItem{".id": "*new", "chain": item["chain"]})
+	case crudDelete:
+		c.deleted = append(c.deleted, url.Path)
+	}
+	return nil
+}
+
+func TestSyncChain(t *testing.T) {
+	s := chainRuleSchema(ResourceIPFirewallFilter().Schema)
+	c := &testChainClient{items: []MikrotikItem{
+		{".id": "*1", "chain": "tf-test", "action": "accept", "src-address": "10.0.0.1", "log": "false"},
+		{".id": "*2", "chain": "tf-test", "action": "drop", "disabled": "true"},
+		{".id": "*3", "chain": "tf-test", "action": "accept", "dynamic": "true"},
+	}}
+
+	want := []MikrotikItem{
+		chainRuleItem(s, map[string]interface{}{"action": "accept", "src_address": "10.0.0.3"}),
+		chainRuleItem(s, map[string]interface{}{"action": "accept", "src_address": "10.0.0.1"}),
+	}
+	if err := syncChain(context.Background(), "/ip/firewall/filter", "tf-test", s, want, c); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(c.deleted, []string{"/ip/firewall/filter/*2"}) {
+		t.Errorf("deleted = %v", c.deleted)
+	}
+	if len(c.created) != 1 || c.created[0]["src-address"] != "10.0.0.3" || c.created[0]["chain"] != "tf-test" {
+		t.Errorf("created = %v", c.created)
+	}
+}
@@ -0,0 +1,237 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var reGenericPath = regexp.MustCompile(`^(/[\w-]+)+$`)
+
+// ResourceGeneric Manages an object of any menu: a RouterOS feature that has no typed resource yet.
+func ResourceGeneric() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/path"),
+		MetaId:           PropId(Id),
+
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "URL path of the menu in the notation ```/iot/modbus```.",
+			ValidateFunc: validation.StringMatch(reGenericPath, ""),
+		},
+		"singleton": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Description: "The menu is a settings menu without items (```/ip/dns```): the attributes are set on create " +
+				"and the settings are not changed on delete.",
+		},
+		"attributes": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Description: "Properties of the object in the RouterOS notation: ```{ \"allow-remote-requests\" = \"yes\" }```. " +
+				"The properties removed from the map are unset.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"values": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "All properties of the object, including the read-only ones.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	resRead := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		path := d.Get("path").(string)
+
+		var item MikrotikItem
+		if d.Get("singleton").(bool) {
+			item = MikrotikItem{}
+			if err := m.(Client).SendRequest(crudRead, &URL{Path: path}, nil, &item); err != nil {
+				ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+				return diag.FromErr(err)
+			}
+		} else {
+			res, err := ReadItems(&ItemId{Id, d.Id()}, path, m.(Client))
+			if err != nil {
+				ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgGet, err))
+				return diag.FromErr(err)
+			}
+
+			// Resource not found.
+			if len(*res) == 0 {
+				d.SetId("")
+				return nil
+			}
+			item = (*res)[0]
+		}
+
+		// Only the configured properties are compared: 'yes' is kept if the router returns 'true'.
+		attributes := map[string]string{}
+		for k, v := range d.Get("attributes").(map[string]interface{}) {
+			value, ok := item[k]
+			if !ok {
+				continue
+			}
+			if genericValueEqual(v.(string), value) {
+				value = v.(string)
+			}
+			attributes[k] = value
+		}
+
+		if err := d.Set("attributes", attributes); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("values", map[string]string(item)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
+	resCreate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		path := d.Get("path").(string)
+		item := genericItem(d.Get("attributes").(map[string]interface{}), nil)
+
+		if d.Get("singleton").(bool) {
+			if err := genericSet(path, item, m.(Client)); err != nil {
+				ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+				return diag.FromErr(err)
+			}
+			d.SetId(strings.ReplaceAll(strings.TrimLeft(path, "/"), "/", "."))
+			return resRead(ctx, d, m)
+		}
+
+		res, err := CreateItem(ctx, item, path, m.(Client))
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
+			return diag.FromErr(err)
+		}
+
+		if res.GetID(Id) == "" {
+			return diag.Errorf("The resource ID was not found in the response")
+		}
+		d.SetId(res.GetID(Id))
+
+		return resRead(ctx, d, m)
+	}
+
+	resUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		path := d.Get("path").(string)
+		old, new := d.GetChange("attributes")
+		item := genericItem(new.(map[string]interface{}), old.(map[string]interface{}))
+
+		var err error
+		if d.Get("singleton").(bool) {
+			err = genericSet(path, item, m.(Client))
+		} else {
+			_, err = UpdateItem(&ItemId{Id, d.Id()}, path, item, m.(Client))
+		}
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+
+		return resRead(ctx, d, m)
+	}
+
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get("singleton").(bool) {
+			return SystemResourceDelete(ctx, resSchema, d, m)
+		}
+
+		if err := DeleteItem(&ItemId{Id, d.Id()}, d.Get("path").(string), m.(Client)); err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		Description: "A resource for any menu path: an escape hatch for the RouterOS features that have no typed " +
+			"resource yet.",
+		CreateContext: resCreate,
+		ReadContext:   resRead,
+		UpdateContext: resUpdate,
+		DeleteContext: resDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: genericImport,
+		},
+
+		Schema: resSchema,
+	}
+}
+
+// genericImport Imports the item by the '/path/*id' or the settings by the '/path' ID.
+func genericImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	path := d.Id()
+	singleton := true
+
+	if i := strings.LastIndex(path, "/"); i > 0 && strings.HasPrefix(path[i+1:], "*") {
+		d.SetId(path[i+1:])
+		path, singleton = path[:i], false
+	} else {
+		d.SetId(strings.ReplaceAll(strings.TrimLeft(path, "/"), "/", "."))
+	}
+
+	if !reGenericPath.MatchString(path) {
+		return nil, fmt.Errorf("wrong import ID '%v', expected /path/*id or /path", path)
+	}
+
+	d.Set("path", path)
+	d.Set("singleton", singleton)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// genericItem Returns the item of the configured attributes, the removed attributes are unset.
+func genericItem(attributes, old map[string]interface{}) MikrotikItem {
+	item := MikrotikItem{}
+	for k, v := range attributes {
+		item[k] = v.(string)
+	}
+
+	for k := range old {
+		if _, ok := attributes[k]; !ok {
+			item["!"+k] = ""
+		}
+	}
+
+	return item
+}
+
+func genericSet(path string, item MikrotikItem, c Client) error {
+	if c.GetTransport() == TransportREST {
+		path += "/set"
+	}
+	return c.SendRequest(crudPost, &URL{Path: path}, item, nil)
+}
+
+func genericValueEqual(config, value string) bool {
+	if config == value {
+		return true
+	}
+
+	switch strings.ToLower(config) {
+	case "yes", "true":
+		return value == "true" || value == "yes"
+	case "no", "false":
+		return value == "false" || value == "no"
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testGenericAddress = "routeros_generic.test"
+
+func TestAccGenericTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/interface/list", "routeros_generic"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccGenericConfig("test comment"),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testGenericAddress),
+							resource.TestCheckResourceAttr(testGenericAddress, "attributes.name", "generic_list"),
+							resource.TestCheckResourceAttr(testGenericAddress, "values.comment", "test comment"),
+						),
+					},
+					{
+						Config: testAccGenericConfig("changed"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testGenericAddress, "values.comment", "changed"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccGenericConfig(comment string) string {
+	return providerConfig + `
+
+resource "routeros_generic" "test" {
+	path       = "/interface/list"
+	attributes = {
+		name    = "generic_list"
+		comment = "` + comment + `"
+	}
+}
+`
+}
+
+func TestGenericImport(t *testing.T) {
+	tests := []struct {
+		id            string
+		wantId        string
+		wantPath      string
+		wantSingleton bool
+		wantErr       bool
+	}{
+		{"/interface/list/*2000010", "*2000010", "/interface/list", false, false},
+		{"/iot/modbus", "iot.modbus", "/iot/modbus", true, false},
+		{"*1", "", "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			d := ResourceGeneric().TestResourceData()
+			d.SetId(tt.id)
+
+			_, err := genericImport(context.Background(), d, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("genericImport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if d.Id() != tt.wantId || d.Get("path") != tt.wantPath || d.Get("singleton") != tt.wantSingleton {
+				t.Errorf("genericImport() = %v, %v, %v", d.Id(), d.Get("path"), d.Get("singleton"))
+			}
+		})
+	}
+}
+
+func TestGenericItem(t *testing.T) {
+	item := genericItem(map[string]interface{}{"name": "list", "comment": "new"},
+		map[string]interface{}{"name": "list", "include": "all"})
+
+	if want := (MikrotikItem{"name": "list", "comment": "new", "!include": ""}); !reflect.DeepEqual(item, want) {
+		t.Errorf("genericItem() = %v, want %v", item, want)
+	}
+	if !genericValueEqual("yes", "true") || genericValueEqual("yes", "false") {
+		t.Errorf("genericValueEqual() is wrong for the boolean values")
+	}
+}
@@ -69,12 +69,12 @@ func ResourceInterfaceBridge() *schema.Resource {
 				"bridge will start functioning normally.",
 			DiffSuppressFunc: TimeEqual,
 		},
-		"forward_reserved_addresses": {
+		"forward_reserved_addresses": WithMinVersion("7.16", &schema.Schema{
 			Type:             schema.TypeBool,
 			Optional:         true,
-			Description:      "An option whether to forward IEEE reserved multicast MAC addresses that are in the `01:80:C2:00:00:0x` range. This option is available in RouterOS starting from version 7.16.",
+			Description:      "An option whether to forward IEEE reserved multicast MAC addresses that are in the `01:80:C2:00:00:0x` range.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"frame_types": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -141,12 +141,12 @@ func ResourceInterfaceBridge() *schema.Resource {
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
 			ValidateFunc:     validation.IntBetween(6, 40),
 		},
-		"max_learned_entries": {
+		"max_learned_entries": WithMinVersion("7.16", &schema.Schema{
 			Type:             schema.TypeString,
 			Optional:         true,
-			Description:      "An option to set the maximum number of learned hosts for the bridge interface. This option is available in RouterOS starting from version 7.16.",
+			Description:      "An option to set the maximum number of learned hosts for the bridge interface.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"max_message_age": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -205,13 +205,13 @@ func ResourceInterfaceBridge() *schema.Resource {
 			ValidateFunc: validation.StringInSlice([]string{"disabled", "permanent", "temporary-query"}, false),
 			RequiredWith: []string{"igmp_snooping"},
 		},
-		"mvrp": {
+		"mvrp": WithMinVersion("7.15", &schema.Schema{
 			Type:     schema.TypeBool,
 			Optional: true,
 			//Default:          false,
-			Description:      "Enables MVRP for bridge (available since RouterOS 7.15). It ensures that the MAC address 01:80:C2:00:00:21 is trapped and not forwarded, the vlan-filtering must be enabled.",
+			Description:      "Enables MVRP for bridge. It ensures that the MAC address 01:80:C2:00:00:21 is trapped and not forwarded, the vlan-filtering must be enabled.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		KeyName: PropNameForceNewRw,
 		"port_cost_mode": {
 			Type:             schema.TypeString,
@@ -1,9 +1,7 @@
 package routeros
 
 import (
-	"context"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -47,7 +45,7 @@ func ResourceInterfaceBridgeFilter() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/bridge/filter"),
 		MetaId:           PropId(Id),
-		MetaSkipFields:   PropSkipFields("bytes", "packets", "invalid"),
+		MetaSkipFields:   PropSkipFields("bytes", "packets", "invalid", "place_before", "place_after"),
 		MetaSetUnsetFields: PropSetUnsetFields("arp_dst_mac_address", "arp_gratuitous", "arp_hardware_type",
 			"arp_opcode", "arp_packet_type", "arp_src_address", "arp_src_mac_address", "dst_address", "dst_mac_address",
 			"dst_port", "in_bridge", "in_bridge_list", "in_interface", "in_interface_list", "ingress_priority",
@@ -256,6 +254,7 @@ func ResourceInterfaceBridgeFilter() *schema.Resource {
 				"filter or not (property only valid some actions).",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
 		},
+		KeyPlaceAfter:  PropPlaceAfter,
 		KeyPlaceBefore: PropPlaceBefore,
 		"src_address": {
 			Type:         schema.TypeString,
@@ -366,17 +365,9 @@ func ResourceInterfaceBridgeFilter() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		CreateContext: DefaultCreate(resSchema),
-		ReadContext:   DefaultRead(resSchema),
-		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			skip := resSchema[MetaSkipFields].Default.(string)
-			resSchema[MetaSkipFields].Default = skip + `,"place_before"`
-			defer func() {
-				resSchema[MetaSkipFields].Default = skip
-			}()
-
-			return ResourceUpdate(ctx, resSchema, d, m)
-		},
+		CreateContext: DefaultPlacedCreate(resSchema),
+		ReadContext:   DefaultPlacedRead(resSchema),
+		UpdateContext: DefaultPlacedUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: ImportStateCustomContext(resSchema),
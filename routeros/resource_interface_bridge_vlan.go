@@ -51,15 +51,15 @@ func ResourceInterfaceBridgeVlan() *schema.Resource {
 		},
 		KeyDisabled: PropDisabledRw,
 		KeyDynamic:  PropDynamicRo,
-		"mvrp_forbidden": {
+		"mvrp_forbidden": WithMinVersion("7.15", &schema.Schema{
 			Type:     schema.TypeList,
 			Optional: true,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Description:      "Ports that ignore all MRP messages and remains Not Registered (MT), as well as disables applicant from declaring specific VLAN ID (available since RouterOS 7.15).",
+			Description:      "Ports that ignore all MRP messages and remains Not Registered (MT), as well as disables applicant from declaring specific VLAN ID.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"tagged": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -95,7 +95,7 @@ func ResourceInterfaceBridgeVlan() *schema.Resource {
 		UpdateContext: DefaultUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema:        resSchema,
@@ -173,14 +173,14 @@ func ResourceInterfaceEthernetSwitch() *schema.Resource {
 		d.SetId(id)
 		d.Set("switch_id", id)
 
-		return MikrotikResourceDataToTerraform((*res)[0], resSchema, d)
+		return MikrotikResourceDataToTerraform(routerOSVersion(m), (*res)[0], resSchema, d)
 	}
 
 	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if d.Id() == "" {
 			d.SetId(d.Get("switch_id").(string))
 		}
-		item, metadata := TerraformResourceDataToMikrotik(resSchema, d)
+		item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
 
 		var resUrl string
 		if m.(Client).GetTransport() == TransportREST {
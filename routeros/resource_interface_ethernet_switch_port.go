@@ -129,7 +129,7 @@ func ResourceInterfaceEthernetSwitchPort() *schema.Resource {
 	}
 
 	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		item, metadata := TerraformResourceDataToMikrotik(resSchema, d)
+		item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
 
 		res, err := ReadItems(&ItemId{Name, d.Get("name").(string)}, metadata.Path, m.(Client))
 		if err != nil {
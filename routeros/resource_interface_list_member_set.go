@@ -0,0 +1,46 @@
+package routeros
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceInterfaceListMemberSet https://help.mikrotik.com/docs/display/ROS/Interface+Lists
+func ResourceInterfaceListMemberSet() *schema.Resource {
+	path := ResourceInterfaceListMember().Schema[MetaResourcePath].Default.(string)
+
+	return &schema.Resource{
+		Description: fmt.Sprintf("*<span style=\"color:#3d85c6\">Authoritative resource: all members of the "+
+			"interface list are managed by it.</span>*\nThe members of the interface list (```%v```) as one "+
+			"resource, e.g. all WAN interfaces that are created by the modules. The missing members are added (by "+
+			"one script) and the members that are not in the set are removed by one request per 100 members, the "+
+			"dynamic members are ignored. The members of the list must not be managed by the "+
+			"```routeros_interface_list_member``` resource at the same time.", path),
+		CreateContext: listMembersSetCreateUpdate(path, "interface", "interfaces", nil),
+		ReadContext:   listMembersSetRead(path, "interface", "interfaces", nil),
+		UpdateContext: listMembersSetCreateUpdate(path, "interface", "interfaces", nil),
+		DeleteContext: listMembersSetDelete(path, "interface", nil),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the interface list.",
+			},
+			"interfaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Names of the interfaces that are members of the list.",
+			},
+		},
+	}
+}
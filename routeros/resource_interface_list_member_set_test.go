@@ -0,0 +1,105 @@
+package routeros
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testInterfaceListMemberSetAddress = "routeros_interface_list_member_set.test"
+
+func TestAccInterfaceListMemberSetTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccInterfaceListMemberSetConfig(`"ether1", "ether2"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testInterfaceListMemberSetAddress, "id", "tf-test"),
+							resource.TestCheckResourceAttr(testInterfaceListMemberSetAddress, "interfaces.#", "2"),
+						),
+					},
+					{
+						Config: testAccInterfaceListMemberSetConfig(`"ether2", "ether3"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testInterfaceListMemberSetAddress, "interfaces.#", "2"),
+							resource.TestCheckTypeSetElemAttr(testInterfaceListMemberSetAddress, "interfaces.*", "ether3"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccInterfaceListMemberSetConfig(interfaces string) string {
+	return providerConfig + `
+
+resource "routeros_interface_list" "test" {
+	name = "tf-test"
+}
+
+resource "routeros_interface_list_member_set" "test" {
+	list       = routeros_interface_list.test.name
+	interfaces = [` + interfaces + `]
+}
+`
+}
+
+type testInterfaceListClient struct {
+	testOperationClient
+	items   []MikrotikItem
+	scripts []string
+	created []string
+	removed []string
+}
+
+func (c *testInterfaceListClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudRead:
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	case crudRunScript:
+		c.scripts = append(c.scripts, item["script"])
+	case crudCreate:
+		c.created = append(c.created, item["list"]+":"+item["interface"])
+	case crudRemove:
+		c.removed = append(c.removed, url.Path+" "+item["numbers"])
+	}
+	return nil
+}
+
+func TestSyncInterfaceListMembers(t *testing.T) {
+	c := &testInterfaceListClient{items: []MikrotikItem{
+		{".id": "*1", "interface": "ether1"},
+		{".id": "*2", "interface": "ether2"},
+		{".id": "*3", "interface": "ether2"},
+		{".id": "*4", "interface": "pppoe-out1", "dynamic": "true"},
+	}}
+
+	want := []string{"ether2", "ether3"}
+	if err := syncListMembers(context.Background(), "/interface/list/member", "WAN", "interface", nil, want,
+		c); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(c.removed, []string{"/interface/list/member/remove *1,*3"}) {
+		t.Errorf("removed = %v", c.removed)
+	}
+	if !reflect.DeepEqual(c.scripts, []string{
+		`:do { /interface/list/member/add interface="ether3" list="WAN" } on-error={}`}) {
+		t.Errorf("scripts = %v", c.scripts)
+	}
+	// The test router does not add the members of the script, they are added one by one.
+	if !reflect.DeepEqual(c.created, []string{"WAN:ether3"}) {
+		t.Errorf("created = %v", c.created)
+	}
+}
@@ -22,15 +22,15 @@ func ResourceInterfaceVlan() *schema.Resource {
 		KeyLoopProtectStatus:       PropLoopProtectStatusRo,
 		KeyMacAddress:              PropMacAddressRo,
 		KeyMtu:                     PropMtuRw(),
-		"mvrp": {
+		"mvrp": WithMinVersion("7.15", &schema.Schema{
 			Type:     schema.TypeBool,
 			Optional: true,
 			//Default:  false,
-			Description: "Specifies whether this VLAN should declare its attributes through Multiple VLAN Registration Protocol (MVRP) as an applicant (available since RouterOS 7.15). " +
+			Description: "Specifies whether this VLAN should declare its attributes through Multiple VLAN Registration Protocol (MVRP) as an applicant. " +
 				"It can be used to register the VLAN with connected bridges that support MVRP. " +
 				"This property only has an effect when use-service-tag is disabled.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		KeyName:    PropNameForceNewRw,
 		KeyRunning: PropRunningRo,
 		"use_service_tag": {
@@ -51,7 +51,7 @@ func ResourceInterfaceVlan() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		SchemaVersion: 1,
@@ -37,19 +37,18 @@ func ResourceInterfaceVxlan() *schema.Resource {
 		MetaResourcePath: PropResourcePath("/interface/vxlan"),
 		MetaId:           PropId(Id),
 
-		"allow_fast_path": {
+		"allow_fast_path": WithMinVersion("7.8", &schema.Schema{
 			Type:     schema.TypeBool,
 			Optional: true,
 			Description: "Whether to allow Fast Path processing. Fragmented and flooded packets over VXLAN are redirected " +
-				"via a slow path. Fast Path is disabled for VXLAN interface that uses IPv6 VTEP version or VRF. The setting " +
-				"is available since RouterOS version 7.8.",
+				"via a slow path. Fast Path is disabled for VXLAN interface that uses IPv6 VTEP version or VRF.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		KeyArp:        PropArpRw,
 		KeyArpTimeout: PropArpTimeoutRw,
 		KeyComment:    PropCommentRw,
 		KeyDisabled:   PropDisabledRw,
-		"dont_fragment": {
+		"dont_fragment": WithMinVersion("7.8", &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: true,
 			Description: "The Don't Fragment (DF) flag controls whether a packet can be broken into smaller packets, " +
@@ -66,10 +65,10 @@ func ResourceInterfaceVxlan() *schema.Resource {
 				"packet is non-IP, the outer IPv4 header will not have the DF flag set and packets can be fragmented. " +
 				"If the inner packet is IPv6, the outer IPv4 header will always set the DF flag and packets cannot be " +
 				"fragmented. Note that when VXLAN uses IPv6 underlay, this setting does not have any effect and is treated " +
-				"the same as disabled. The setting is available since RouterOS version 7.8.",
+				"the same as disabled.",
 			ValidateFunc:     validation.StringInSlice([]string{"disabled", "enabled", "inherit"}, false),
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"group": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -86,14 +85,13 @@ func ResourceInterfaceVxlan() *schema.Resource {
 			Description: "Interface name used for multicast forwarding. This property requires specifying the group " +
 				"setting.",
 		},
-		"local_address": {
+		"local_address": WithMinVersion("7.7", &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: true,
 			Description: "Specifies the local source address for the VXLAN interface. If not set, one IP address of " +
 				"the egress interface will be selected as a source address for VXLAN packets. When the property is set, " +
-				"the vteps-ip-version automatically gets updated to the used local IP version. The setting is available " +
-				"since RouterOS version 7.7.",
-		},
+				"the vteps-ip-version automatically gets updated to the used local IP version.",
+		}),
 		"mac_address": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -0,0 +1,99 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+    ".id": "*1",
+    "antenna-gain": "3",
+    "channel-plan": "EU868",
+    "disabled": "false",
+    "forward": "crc-valid",
+    "hardware-id": "A840411F8A9C4150",
+    "lbt-enabled": "false",
+    "name": "lora1",
+    "network": "public",
+    "servers": "TTN-EU",
+    "src-address": ""
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/LoRa
+func ResourceIotLora() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/iot/lora"),
+		MetaId:           PropId(Id),
+		MetaSkipFields:   PropSkipFields("name"),
+
+		"antenna_gain": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "Antenna gain in dBi.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"channel_plan": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The regional channel plan of the gateway (`EU868`, `US915`, `AU915`, `AS923` etc.).",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyDisabled: PropDisabledRw,
+		"forward": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "A comma-separated list of the packet types that are forwarded to the network servers: " +
+				"`crc-valid`, `crc-errors`, `crc-disabled`.",
+			ValidateDiagFunc: ValidationMultiValInSlice([]string{"crc-valid", "crc-errors", "crc-disabled"}, false, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"hardware_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The hardware ID of the LoRa card.",
+		},
+		"lbt_enabled": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Whether to enable the Listen Before Talk (LBT) feature.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: PropName("Name of the LoRa device."),
+		"network": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The LoRaWAN network type.",
+			ValidateFunc:     validation.StringInSlice([]string{"private", "public"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"servers": {
+			Type:             schema.TypeSet,
+			Optional:         true,
+			Elem:             &schema.Schema{Type: schema.TypeString},
+			Description:      "Names of the network servers (`routeros_iot_lora_server`) the packets are forwarded to.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"src_address": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Source IP address used for the traffic towards the network servers.",
+			ValidateFunc:     validation.Any(validation.IsIPAddress, validation.StringIsEmpty),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreateUpdate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultCreateUpdate(resSchema),
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
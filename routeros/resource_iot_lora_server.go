@@ -0,0 +1,57 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+    ".id": "*1",
+    "address": "eu1.cloud.thethings.industries",
+    "down-port": "1700",
+    "name": "TTN-EU",
+    "up-port": "1700"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/LoRa
+func ResourceIotLoraServer() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/iot/lora/servers"),
+		MetaId:           PropId(Id),
+
+		"address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "IP address or hostname of the LoRaWAN network server.",
+		},
+		"down_port": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "UDP port used for the downlink traffic.",
+			ValidateFunc:     Validation64k,
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: PropName("Descriptive name of the network server."),
+		"up_port": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "UDP port used for the uplink traffic.",
+			ValidateFunc:     Validation64k,
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
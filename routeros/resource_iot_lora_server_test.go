@@ -0,0 +1,67 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIotLoraServer = "routeros_iot_lora_server.test"
+
+func TestAccIotLoraServerTest_basic(t *testing.T) {
+	if !testCheckIotPackage(t) {
+		t.Logf("Test skipped, the iot package is not installed")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/iot/lora/servers", "routeros_iot_lora_server"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIotLoraServerConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testIotLoraServer),
+							resource.TestCheckResourceAttr(testIotLoraServer, "name", "test-lora"),
+							resource.TestCheckResourceAttr(testIotLoraServer, "address", "lora.example.com"),
+							resource.TestCheckResourceAttr(testIotLoraServer, "up_port", "1701"),
+							resource.TestCheckResourceAttr(testIotLoraServer, "down_port", "1702"),
+						),
+					},
+					{
+						Config:        testAccIotLoraServerConfig(),
+						ResourceName:  testIotLoraServer,
+						ImportStateId: `name=test-lora`,
+						ImportState:   true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 {
+								return fmt.Errorf("more than 1 states received, only one expected")
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccIotLoraServerConfig() string {
+	return providerConfig + `
+
+resource "routeros_iot_lora_server" "test" {
+  name      = "test-lora"
+  address   = "lora.example.com"
+  up_port   = 1701
+  down_port = 1702
+}
+`
+}
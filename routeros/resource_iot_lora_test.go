@@ -0,0 +1,70 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIotLora = "routeros_iot_lora.test"
+
+func TestAccIotLoraTest_basic(t *testing.T) {
+	if !testCheckIotPackage(t) || !testCheckRouterItems(t, "/iot/lora", "name=lora1") {
+		t.Logf("Test skipped, the router does not have the lora1 LoRa device")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIotLoraConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testIotLora),
+							resource.TestCheckResourceAttr(testIotLora, "name", "lora1"),
+							resource.TestCheckResourceAttr(testIotLora, "disabled", "true"),
+							resource.TestCheckResourceAttr(testIotLora, "antenna_gain", "3"),
+							resource.TestCheckResourceAttr(testIotLora, "network", "private"),
+							resource.TestCheckResourceAttrSet(testIotLora, "hardware_id"),
+						),
+					},
+					{
+						Config:       testAccIotLoraConfig(),
+						ResourceName: testIotLora,
+						ImportStateIdFunc: func(s *terraform.State) (string, error) {
+							return s.RootModule().Resources[testIotLora].Primary.ID, nil
+						},
+						ImportState: true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 {
+								return fmt.Errorf("more than 1 states received, only one expected")
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+// The device is not removed on destroy, so it does not reference the servers of the test.
+func testAccIotLoraConfig() string {
+	return providerConfig + `
+
+resource "routeros_iot_lora" "test" {
+  name         = "lora1"
+  antenna_gain = 3
+  network      = "private"
+  disabled     = true
+}
+`
+}
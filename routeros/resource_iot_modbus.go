@@ -0,0 +1,57 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+    "disabled": "true",
+    "hardware-port": "modbus",
+    "tcp-port": "502",
+    "timeout": "1000"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Modbus
+func ResourceIotModbus() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/iot/modbus"),
+		MetaId:           PropId(Id),
+
+		KeyDisabled: PropDisabledRw,
+		"hardware_port": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The serial port of the device that is used for the Modbus RTU communication.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"tcp_port": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The TCP port of the Modbus TCP to RTU gateway.",
+			ValidateFunc:     validation.IntBetween(1, 65535),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"timeout": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The time (in milliseconds) to wait for a response from the Modbus slave device.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultSystemCreate(resSchema),
+		ReadContext:   DefaultSystemRead(resSchema),
+		UpdateContext: DefaultSystemUpdate(resSchema),
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
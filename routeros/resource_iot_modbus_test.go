@@ -0,0 +1,65 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIotModbus = "routeros_iot_modbus.test"
+
+func TestAccIotModbusTest_basic(t *testing.T) {
+	if !testCheckIotPackage(t) {
+		t.Logf("Test skipped, the iot package is not installed")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIotModbusConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testIotModbus),
+							resource.TestCheckResourceAttr(testIotModbus, "disabled", "true"),
+							resource.TestCheckResourceAttr(testIotModbus, "tcp_port", "5020"),
+							resource.TestCheckResourceAttr(testIotModbus, "timeout", "2000"),
+						),
+					},
+					{
+						Config:        testAccIotModbusConfig(),
+						ResourceName:  testIotModbus,
+						ImportStateId: ".",
+						ImportState:   true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 {
+								return fmt.Errorf("more than 1 states received, only one expected")
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+// The gateway is not enabled, the test router may not have the serial port.
+func testAccIotModbusConfig() string {
+	return providerConfig + `
+
+resource "routeros_iot_modbus" "test" {
+  disabled = true
+  tcp_port = 5020
+  timeout  = 2000
+}
+`
+}
@@ -0,0 +1,106 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+    ".id": "*1",
+    "address": "192.168.88.2",
+    "auto-connect": "true",
+    "certificate": "",
+    "client-id": "router-01",
+    "keep-alive": "60",
+    "name": "mqtt-01",
+    "parallel-scripts-limit": "1",
+    "password": "password",
+    "port": "1883",
+    "ssl": "false",
+    "username": "router"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/MQTT
+func ResourceIotMqttBroker() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/iot/mqtt/brokers"),
+		MetaId:           PropId(Id),
+
+		"address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "IP address or hostname of the MQTT broker.",
+		},
+		"auto_connect": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Whether the router automatically connects to the broker and reconnects to it on connection loss.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"certificate": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The certificate to be used for the SSL connection.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"client_id": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "A unique ID used for the connection. The broker uses this ID to identify the client.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"keep_alive": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The maximum time interval in seconds between the messages sent to the broker.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: PropName("Descriptive name of the broker."),
+		"parallel_scripts_limit": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The maximum number of `on-message` scripts that are allowed to run in parallel.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Password for the broker (if required by the broker).",
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1883,
+			Description:  "Network port used by the broker.",
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"ssl": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Whether to use a secure SSL connection to the broker.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"username": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Username for the broker (if required by the broker).",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
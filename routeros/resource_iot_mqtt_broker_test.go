@@ -0,0 +1,75 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIotMqttBroker = "routeros_iot_mqtt_broker.test"
+
+// testCheckIotPackage Returns true if the iot package is installed and enabled on the test router.
+func testCheckIotPackage(t *testing.T) bool {
+	return testCheckRouterItems(t, "/system/package", "name=iot", "disabled=false")
+}
+
+func TestAccIotMqttBrokerTest_basic(t *testing.T) {
+	if !testCheckIotPackage(t) {
+		t.Logf("Test skipped, the iot package is not installed")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/iot/mqtt/brokers", "routeros_iot_mqtt_broker"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIotMqttBrokerConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testIotMqttBroker),
+							resource.TestCheckResourceAttr(testIotMqttBroker, "name", "test-mqtt"),
+							resource.TestCheckResourceAttr(testIotMqttBroker, "address", "192.0.2.1"),
+							resource.TestCheckResourceAttr(testIotMqttBroker, "auto_connect", "false"),
+							resource.TestCheckResourceAttr(testIotMqttBroker, "client_id", "test-router"),
+							resource.TestCheckResourceAttr(testIotMqttBroker, "username", "router"),
+						),
+					},
+					{
+						Config:        testAccIotMqttBrokerConfig(),
+						ResourceName:  testIotMqttBroker,
+						ImportStateId: `name=test-mqtt`,
+						ImportState:   true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 {
+								return fmt.Errorf("more than 1 states received, only one expected")
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccIotMqttBrokerConfig() string {
+	return providerConfig + `
+
+resource "routeros_iot_mqtt_broker" "test" {
+  name         = "test-mqtt"
+  address      = "192.0.2.1"
+  auto_connect = false
+  client_id    = "test-router"
+  username     = "router"
+  password     = "secret"
+}
+`
+}
@@ -0,0 +1,63 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+    ".id": "*1",
+    "broker": "mqtt-01",
+    "disabled": "false",
+    "on-message": ":log info $msgData",
+    "qos": "0",
+    "topic": "router/commands"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/MQTT
+func ResourceIotMqttSubscription() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/iot/mqtt/subscriptions"),
+		MetaId:           PropId(Id),
+
+		"broker": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the MQTT broker to subscribe to.",
+		},
+		KeyDisabled: PropDisabledRw,
+		"on_message": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "A script that is executed when a message is received. The message topic and payload are " +
+				"available in the `$msgTopic` and `$msgData` variables.",
+		},
+		"qos": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The Quality of Service level of the subscription.",
+			ValidateFunc:     validation.IntBetween(0, 2),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"topic": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The topic to subscribe to. MQTT wildcards (`+`, `#`) are supported.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
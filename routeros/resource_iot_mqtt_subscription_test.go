@@ -0,0 +1,72 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testIotMqttSubscription = "routeros_iot_mqtt_subscription.test"
+
+func TestAccIotMqttSubscriptionTest_basic(t *testing.T) {
+	if !testCheckIotPackage(t) {
+		t.Logf("Test skipped, the iot package is not installed")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/iot/mqtt/subscriptions", "routeros_iot_mqtt_subscription"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIotMqttSubscriptionConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testIotMqttSubscription),
+							resource.TestCheckResourceAttr(testIotMqttSubscription, "broker", "test-mqtt"),
+							resource.TestCheckResourceAttr(testIotMqttSubscription, "topic", "test/commands"),
+							resource.TestCheckResourceAttr(testIotMqttSubscription, "qos", "1"),
+							resource.TestCheckResourceAttr(testIotMqttSubscription, "on_message", ":log info $msgData"),
+						),
+					},
+					{
+						Config:        testAccIotMqttSubscriptionConfig(),
+						ResourceName:  testIotMqttSubscription,
+						ImportStateId: `topic=test/commands`,
+						ImportState:   true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 {
+								return fmt.Errorf("more than 1 states received, only one expected")
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccIotMqttSubscriptionConfig() string {
+	return providerConfig + `
+
+resource "routeros_iot_mqtt_broker" "test" {
+  name    = "test-mqtt"
+  address = "192.0.2.1"
+}
+
+resource "routeros_iot_mqtt_subscription" "test" {
+  broker     = routeros_iot_mqtt_broker.test.name
+  topic      = "test/commands"
+  qos        = 1
+  on_message = ":log info $msgData"
+}
+`
+}
@@ -19,6 +19,7 @@ import (
 func ResourceIpDnsForwarders() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/dns/forwarders"),
+		MetaMinVersion:   PropMinVersion("7.17"),
 		MetaId:           PropId(Id),
 
 		KeyComment:  PropCommentRw,
@@ -0,0 +1,62 @@
+package routeros
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var reAddrListRange = regexp.MustCompile(`^(\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3})\s*-\s*(\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3})$`)
+
+// ResourceIPFirewallAddrListSet https://help.mikrotik.com/docs/display/ROS/Address-lists
+func ResourceIPFirewallAddrListSet() *schema.Resource {
+	path := ResourceIPFirewallAddrList().Schema[MetaResourcePath].Default.(string)
+
+	return &schema.Resource{
+		Description: fmt.Sprintf("*<span style=\"color:#3d85c6\">Authoritative resource: all entries of the "+
+			"address list are managed by it.</span>*\nThe addresses of the ```%v``` list as one resource, so that "+
+			"large lists do not need a resource per entry. The changes are sent in batches of %v entries: the "+
+			"missing entries are added by one script per batch and the entries that are not in the set are "+
+			"removed by one request per batch, the dynamic entries (with a timeout or added by the firewall rules) "+
+			"are ignored. The entries of the list must not be managed by the ```routeros_ip_firewall_addr_list``` "+
+			"resource at the same time.", path, itemsBatchSize),
+		CreateContext: listMembersSetCreateUpdate(path, "address", "addresses", normalizeAddrListAddress),
+		ReadContext:   listMembersSetRead(path, "address", "addresses", normalizeAddrListAddress),
+		UpdateContext: listMembersSetCreateUpdate(path, "address", "addresses", normalizeAddrListAddress),
+		DeleteContext: listMembersSetDelete(path, "address", normalizeAddrListAddress),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the address list.",
+			},
+			"addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "IP addresses, subnets, ranges or DNS names of the list. The ranges are stored by the " +
+					"router as subnets when possible: '192.168.0.0-192.168.1.255' is '192.168.0.0/23'.",
+			},
+		},
+	}
+}
+
+// normalizeAddrListAddress Returns the address in the router notation.
+func normalizeAddrListAddress(address string) string {
+	if ips := reAddrListRange.FindStringSubmatch(address); len(ips) == 3 {
+		if s, err := IpRangeToCIDR(ips[1], ips[2]); err == nil {
+			address = s
+		}
+	}
+	return strings.TrimSuffix(address, "/32")
+}
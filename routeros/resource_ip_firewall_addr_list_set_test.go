@@ -0,0 +1,205 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testIPFirewallAddrListSetAddress = "routeros_ip_firewall_addr_list_set.test"
+
+func TestAccIPFirewallAddrListSetTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccIPFirewallAddrListSetConfig(`"10.0.0.1", "10.0.1.0/24", "192.168.0.0-192.168.1.255"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPFirewallAddrListSetAddress, "id", "tf-test"),
+							resource.TestCheckResourceAttr(testIPFirewallAddrListSetAddress, "addresses.#", "3"),
+						),
+					},
+					{
+						Config: testAccIPFirewallAddrListSetConfig(`"10.0.0.1", "10.0.2.0/24"`),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(testIPFirewallAddrListSetAddress, "addresses.#", "2"),
+							resource.TestCheckTypeSetElemAttr(testIPFirewallAddrListSetAddress, "addresses.*", "10.0.2.0/24"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccIPFirewallAddrListSetConfig(addresses string) string {
+	return providerConfig + `
+
+resource "routeros_ip_firewall_addr_list_set" "test" {
+	list      = "tf-test"
+	addresses = [` + addresses + `]
+}
+`
+}
+
+func TestNormalizeAddrListAddress(t *testing.T) {
+	tests := map[string]string{
+		"10.0.0.1":                  "10.0.0.1",
+		"10.0.0.1/32":               "10.0.0.1",
+		"10.0.0.0/24":               "10.0.0.0/24",
+		"192.168.0.0-192.168.1.255": "192.168.0.0/23",
+		"10.0.0.1-10.0.0.5":         "10.0.0.1-10.0.0.5",
+		"example.com":               "example.com",
+	}
+	for address, want := range tests {
+		if got := normalizeAddrListAddress(address); got != want {
+			t.Errorf("normalizeAddrListAddress(%v) = %v, want %v", address, got, want)
+		}
+	}
+}
+
+type testAddrListClient struct {
+	testOperationClient
+	items   []MikrotikItem
+	scripts []string
+	created []string
+	removed []string
+	// The addresses that the router rejects.
+	rejected map[string]bool
+}
+
+var reTestAddrListAdd = regexp.MustCompile(`address="([^"]*)"`)
+
+func (c *testAddrListClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudRead:
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	case crudRunScript:
+		c.scripts = append(c.scripts, item["script"])
+		for _, m := range reTestAddrListAdd.FindAllStringSubmatch(item["script"], -1) {
+			if !c.rejected[m[1]] {
+				c.items = append(c.items, MikrotikItem{".id": "*new", "address": m[1]})
+			}
+		}
+	case crudCreate:
+		if c.rejected[item["address"]] {
+			return fmt.Errorf("invalid value for argument address")
+		}
+		c.created = append(c.created, item["list"]+":"+item["address"])
+	case crudRemove:
+		c.removed = append(c.removed, url.Path+" "+item["numbers"])
+	}
+	return nil
+}
+
+func TestSyncAddrList(t *testing.T) {
+	c := &testAddrListClient{items: []MikrotikItem{
+		{".id": "*1", "address": "10.0.0.1"},
+		{".id": "*2", "address": "10.0.1.0/24"},
+		{".id": "*3", "address": "192.168.0.0/23"},
+		{".id": "*4", "address": "10.0.9.9", "dynamic": "true"},
+	}}
+
+	want := []string{"10.0.0.1/32", "192.168.0.0-192.168.1.255", "10.0.2.0/24"}
+	if err := syncListMembers(context.Background(), "/ip/firewall/address-list", "tf-test", "address",
+		normalizeAddrListAddress, want, c); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(c.removed, []string{"/ip/firewall/address-list/remove *2"}) {
+		t.Errorf("removed = %v", c.removed)
+	}
+	if !reflect.DeepEqual(c.scripts, []string{
+		`:do { /ip/firewall/address-list/add address="10.0.2.0/24" list="tf-test" } on-error={}`}) {
+		t.Errorf("scripts = %v", c.scripts)
+	}
+	if len(c.created) != 0 {
+		t.Errorf("created = %v", c.created)
+	}
+}
+
+func TestSyncAddrListRejected(t *testing.T) {
+	c := &testAddrListClient{rejected: map[string]bool{"bad": true}}
+
+	err := syncListMembers(context.Background(), "/ip/firewall/address-list", "tf-test", "address",
+		normalizeAddrListAddress, []string{"10.0.0.1", "bad"}, c)
+	if err == nil || err.Error() != "address 'bad': invalid value for argument address" {
+		t.Fatalf("syncListMembers() error = %v", err)
+	}
+	if len(c.scripts) != 1 || strings.Count(c.scripts[0], "\n") != 1 {
+		t.Errorf("scripts = %v", c.scripts)
+	}
+}
+
+func TestSyncAddrListBatches(t *testing.T) {
+	c := &testAddrListClient{}
+	for i := 0; i < itemsBatchSize+1; i++ {
+		c.items = append(c.items, MikrotikItem{".id": fmt.Sprintf("*%X", i), "address": fmt.Sprintf("10.0.%d.%d", i/256, i%256)})
+	}
+
+	if err := syncListMembers(context.Background(), "/ip/firewall/address-list", "tf-test", "address",
+		normalizeAddrListAddress, nil, c); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.removed) != 2 || strings.Count(c.removed[0], ",") != itemsBatchSize-1 ||
+		c.removed[1] != fmt.Sprintf("/ip/firewall/address-list/remove *%X", itemsBatchSize) {
+		t.Errorf("removed = %v", c.removed)
+	}
+
+	var want []string
+	for _, item := range c.items {
+		want = append(want, item["address"])
+	}
+	c.items, c.removed = nil, nil
+	if err := syncListMembers(context.Background(), "/ip/firewall/address-list", "tf-test", "address",
+		normalizeAddrListAddress, want, c); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(c.scripts) != 2 || strings.Count(c.scripts[0], "\n") != itemsBatchSize-1 ||
+		strings.Count(c.scripts[1], "\n") != 0 || len(c.created) != 0 {
+		t.Errorf("scripts = %v, created = %v", len(c.scripts), c.created)
+	}
+}
+
+type testReadOnlyAddrListClient struct {
+	testAddrListClient
+}
+
+func (c *testReadOnlyAddrListClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	return doRequest(context.Background(), &ExtraParams{ReadOnly: true}, TransportREST, method, url, item,
+		func(context.Context) error {
+			return c.testAddrListClient.SendRequest(method, url, item, result)
+		})
+}
+
+func TestIPFirewallAddrListSetReadOnly(t *testing.T) {
+	r := ResourceIPFirewallAddrListSet()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"list":      "tf-test",
+		"addresses": []interface{}{"10.0.0.1"},
+	})
+
+	c := &testReadOnlyAddrListClient{}
+	diags := r.CreateContext(context.Background(), d, c)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "read-only mode") {
+		t.Fatalf("CreateContext() = %v, want the read-only error", diags)
+	}
+	if len(c.scripts) != 0 || len(c.created) != 0 {
+		t.Errorf("scripts = %v, created = %v", c.scripts, c.created)
+	}
+}
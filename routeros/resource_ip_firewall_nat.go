@@ -1,10 +1,8 @@
 package routeros
 
 import (
-	"context"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -31,7 +29,7 @@ func ResourceIPFirewallNat() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/ip/firewall/nat"),
 		MetaId:           PropId(Id),
-		MetaSkipFields:   PropSkipFields("bytes", "packets"),
+		MetaSkipFields:   PropSkipFields("bytes", "packets", "place_before", "place_after"),
 		MetaSetUnsetFields: PropSetUnsetFields("dst_address", "dst_address_list", "src_address", "src_address_list",
 			"in_interface", "in_interface_list", "out_interface", "out_interface_list", "in_bridge_port_list",
 			"out_bridge_port_list"),
@@ -278,6 +276,7 @@ func ResourceIPFirewallNat() *schema.Resource {
 			Description: "PCC matcher allows dividing traffic into equal streams with the ability to keep packets " +
 				"with a specific set of options in one particular stream.",
 		},
+		KeyPlaceAfter:  PropPlaceAfter,
 		KeyPlaceBefore: PropPlaceBefore,
 		"port": {
 			Type:     schema.TypeString,
@@ -383,20 +382,12 @@ func ResourceIPFirewallNat() *schema.Resource {
 		},
 	}
 	return &schema.Resource{
-		CreateContext: DefaultCreate(resSchema),
-		ReadContext:   DefaultRead(resSchema),
-		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			skip := resSchema[MetaSkipFields].Default.(string)
-			resSchema[MetaSkipFields].Default = skip + `,"place_before"`
-			defer func() {
-				resSchema[MetaSkipFields].Default = skip
-			}()
-
-			return ResourceUpdate(ctx, resSchema, d, m)
-		},
+		CreateContext: DefaultPlacedCreate(resSchema),
+		ReadContext:   DefaultPlacedRead(resSchema),
+		UpdateContext: DefaultPlacedUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
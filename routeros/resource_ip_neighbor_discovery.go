@@ -26,13 +26,12 @@ func ResourceIpNeighborDiscoverySettings() *schema.Resource {
 			Description:      "Interface list on which members the discovery protocol will run on.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
 		},
-		"discover_interval": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Description: "An option to adjust the frequency at which neighbor discovery packets are transmitted. " +
-				"The setting is available since RouterOS version 7.16.",
+		"discover_interval": WithMinVersion("7.16", &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "An option to adjust the frequency at which neighbor discovery packets are transmitted.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"lldp_dcbx": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -79,21 +78,19 @@ func ResourceIpNeighborDiscoverySettings() *schema.Resource {
 				"Equipment (PSE) and Powered Devices (PD).",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
 		},
-		"lldp_vlan_info": {
-			Type:     schema.TypeBool,
-			Optional: true,
-			Description: "An option whether to send IEEE 802.1 Organizationally Specific TLVs in LLDP related to VLANs. " +
-				"The setting is available since RouterOS version 7.16.",
+		"lldp_vlan_info": WithMinVersion("7.16", &schema.Schema{
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "An option whether to send IEEE 802.1 Organizationally Specific TLVs in LLDP related to VLANs.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
-		"mode": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Description: "Selects the neighbor discovery packet sending and receiving mode. The setting is " +
-				"available since RouterOS version 7.7.",
+		}),
+		"mode": WithMinVersion("7.7", &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Selects the neighbor discovery packet sending and receiving mode.",
 			ValidateFunc:     validation.StringInSlice([]string{"rx-only", "tx-only", "tx-and-rx"}, false),
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"protocol": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -59,13 +59,13 @@ func ResourceIpService() *schema.Resource {
 		KeyDisabled: PropDisabledRw,
 		KeyDynamic:  PropDynamicRo,
 		KeyInvalid:  PropInvalidRo,
-		"max_sessions": {
+		"max_sessions": WithMinVersion("7.16", &schema.Schema{
 			Type:             schema.TypeInt,
 			Optional:         true,
-			Description:      "Maximum number of concurrent connections to a particular service. This option is available in RouterOS starting from version 7.16.",
+			Description:      "Maximum number of concurrent connections to a particular service.",
 			ValidateFunc:     validation.IntAtLeast(1),
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"name": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -100,7 +100,7 @@ func ResourceIpService() *schema.Resource {
 	}
 
 	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		item, metadata := TerraformResourceDataToMikrotik(resSchema, d)
+		item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
 
 		d.SetId(d.Get("numbers").(string))
 
@@ -33,6 +33,7 @@ import (
 func ResourceOpenVPNServer() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/ovpn-server/server"),
+		MetaMinVersion:   PropMinVersion("7.8"),
 		MetaId:           PropId(Id),
 
 		"auth": {
@@ -139,13 +140,13 @@ func ResourceOpenVPNServer() *schema.Resource {
 			Description:  "indicates the protocol to use when connecting with the remote endpoint.",
 			ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
 		},
-		"push_routes": {
+		"push_routes": WithMinVersion("7.14", &schema.Schema{
 			Type:             schema.TypeSet,
 			Optional:         true,
 			Elem:             &schema.Schema{Type: schema.TypeString},
-			Description:      "Push routes to the VPN client (available since RouterOS 7.14).",
+			Description:      "Push routes to the VPN client.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"redirect_gateway": {
 			Type:     schema.TypeSet,
 			Optional: true,
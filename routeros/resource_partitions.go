@@ -0,0 +1,109 @@
+package routeros
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+  ".id": "*0",
+  "active": "true",
+  "fallback-to": "next",
+  "name": "part0",
+  "running": "true",
+  "size": "64MiB",
+  "version": "7.19.1"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Partitions
+func ResourcePartitions() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/partitions"),
+		MetaId:           PropId(Id),
+		MetaSkipFields:   PropSkipFields("name", "activate"),
+
+		"activate": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Make this partition the active one. The router boots from the active partition after " +
+				"the next reboot.",
+		},
+		"active": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the partition is the one the router boots from.",
+		},
+		"fallback_to": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The partition to boot from if booting from this partition fails: `next`, `none` or " +
+				"the name of a partition.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: PropName("Partition name."),
+		"running": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the router is currently running from this partition.",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Partition size.",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The RouterOS version installed on the partition.",
+		},
+	}
+
+	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := DefaultCreateUpdate(resSchema)(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+
+		if d.Get("activate").(bool) && !d.Get("active").(bool) {
+			if diags = activatePartition(ctx, resSchema, d, m); diags.HasError() {
+				return diags
+			}
+			return ResourceRead(ctx, resSchema, d, m)
+		}
+
+		return diags
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreateUpdate,
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: resCreateUpdate,
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
+
+func activatePartition(ctx context.Context, s map[string]*schema.Schema, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var resUrl = &URL{
+		Path: s[MetaResourcePath].Default.(string),
+	}
+	if m.(Client).GetTransport() == TransportREST {
+		resUrl.Path += "/activate"
+	}
+
+	err := m.(Client).SendRequest(crudActivate, resUrl, MikrotikItem{"numbers": d.Id()}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
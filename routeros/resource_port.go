@@ -0,0 +1,104 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+  ".id": "*1",
+  "baud-rate": "115200",
+  "channels": "1",
+  "data-bits": "8",
+  "dtr": "on",
+  "flow-control": "none",
+  "name": "serial0",
+  "parity": "none",
+  "rts": "on",
+  "stop-bits": "1",
+  "used-by": "Serial Console"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Serial+Console
+func ResourcePort() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/port"),
+		MetaId:           PropId(Id),
+		MetaSkipFields:   PropSkipFields("name"),
+
+		"baud_rate": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Port speed in bits per second or `auto`.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"channels": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of channels the port provides.",
+		},
+		"data_bits": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "Number of data bits in a character.",
+			ValidateFunc:     validation.IntBetween(7, 8),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"dtr": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Data Terminal Ready signal state.",
+			ValidateFunc:     validation.StringInSlice([]string{"off", "on"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"flow_control": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Flow control method.",
+			ValidateFunc:     validation.StringInSlice([]string{"hardware", "none", "xon-xoff"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: PropName("Port name."),
+		"parity": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Parity checking method.",
+			ValidateFunc:     validation.StringInSlice([]string{"even", "none", "odd"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"rts": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Request To Send signal state.",
+			ValidateFunc:     validation.StringInSlice([]string{"off", "on"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"stop_bits": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "Number of stop bits after each character.",
+			ValidateFunc:     validation.IntBetween(1, 2),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"used_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The service that currently uses the port.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreateUpdate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultCreateUpdate(resSchema),
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
@@ -0,0 +1,54 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testPort = "routeros_port.test"
+
+func TestAccPortTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/port", "name=serial0") {
+		t.Logf("Test skipped, the router does not have the serial0 port")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccPortConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testPort),
+							resource.TestCheckResourceAttr(testPort, "name", "serial0"),
+							resource.TestCheckResourceAttr(testPort, "baud_rate", "115200"),
+							resource.TestCheckResourceAttr(testPort, "data_bits", "8"),
+							resource.TestCheckResourceAttr(testPort, "parity", "none"),
+							resource.TestCheckResourceAttr(testPort, "stop_bits", "1"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccPortConfig() string {
+	return providerConfig + `
+
+resource "routeros_port" "test" {
+  name      = "serial0"
+  baud_rate = "115200"
+  data_bits = 8
+  parity    = "none"
+  stop_bits = 1
+}
+`
+}
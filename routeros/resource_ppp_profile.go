@@ -81,6 +81,19 @@ func ResourcePPPProfile() *schema.Resource {
 				"no effect when a bridge protocol-mode is set  to none.",
 			ValidateFunc: validation.IntBetween(0, 240),
 		},
+		"bridge_port_trusted": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Sets the trusted flag for the dynamically created bridge port.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"bridge_port_vid": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "Port VLAN ID (pvid) for the dynamically created bridge port.",
+			ValidateFunc:     validation.IntBetween(1, 4094),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
 		"change_tcp_mss": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -277,7 +290,7 @@ func ResourcePPPProfile() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
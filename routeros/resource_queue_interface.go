@@ -0,0 +1,130 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+  ".id": "*1",
+  "active-queue": "only-hardware-queue",
+  "default-queue": "only-hardware-queue",
+  "interface": "ether1",
+  "queue": "only-hardware-queue"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Queues#Queues-InterfaceQueue
+func ResourceQueueInterface() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/queue/interface"),
+		MetaId:           PropId(Id),
+
+		"active_queue": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The queue type that is currently used on the interface.",
+		},
+		"default_queue": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The queue type that is used on the interface by default.",
+		},
+		"interface": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the interface.",
+		},
+		"queue": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The queue type (`/queue/type`) to use on the interface.",
+		},
+	}
+
+	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
+
+		filter := buildReadFilter(map[string]interface{}{"interface": d.Get("interface")})
+		res, err := ReadItemsFiltered(filter, metadata.Path, m.(Client))
+		if err != nil {
+			// API/REST client error.
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+
+		// Resource not found.
+		if len(*res) == 0 {
+			d.SetId("")
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(errorNoLongerExists)
+		}
+
+		d.SetId((*res)[0].GetID(Id))
+		item[".id"] = d.Id()
+		// The interface is the lookup key and cannot be changed.
+		delete(item, "interface")
+
+		var resUrl string
+		if m.(Client).GetTransport() == TransportREST {
+			resUrl = "/set"
+		}
+
+		err = m.(Client).SendRequest(crudPost, &URL{Path: metadata.Path + resUrl}, item, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return ResourceRead(ctx, resSchema, d, m)
+	}
+
+	// The interface queue cannot be removed, the queue type is reset to the default queue of the interface.
+	resDelete := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		metadata := GetMetadata(resSchema)
+
+		res, err := ReadItems(&ItemId{Id, d.Id()}, metadata.Path, m.(Client), ".id", "default-queue")
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+
+		if len(*res) == 0 || (*res)[0]["default-queue"] == "" {
+			d.SetId("")
+			return nil
+		}
+
+		var resUrl string
+		if m.(Client).GetTransport() == TransportREST {
+			resUrl = "/set"
+		}
+
+		item := MikrotikItem{".id": d.Id(), "queue": (*res)[0]["default-queue"]}
+		err = m.(Client).SendRequest(crudPost, &URL{Path: metadata.Path + resUrl}, item, nil)
+		if err != nil {
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgDelete, err))
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		Description:   "The queue type of an interface. The destroy resets the queue type to the default queue of the interface.",
+		CreateContext: resCreateUpdate,
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: resCreateUpdate,
+		DeleteContext: resDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
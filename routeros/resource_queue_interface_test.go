@@ -0,0 +1,101 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testQueueInterface = "routeros_queue_interface.test"
+
+func TestAccQueueInterfaceTest_basic(t *testing.T) {
+	// t.Parallel()
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccQueueInterfaceConfig("ethernet-default"),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testQueueInterface),
+							resource.TestCheckResourceAttr(testQueueInterface, "interface", "ether1"),
+							resource.TestCheckResourceAttr(testQueueInterface, "queue", "ethernet-default"),
+						),
+					},
+					{
+						Config: testAccQueueInterfaceConfig("only-hardware-queue"),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testQueueInterface),
+							resource.TestCheckResourceAttr(testQueueInterface, "interface", "ether1"),
+							resource.TestCheckResourceAttr(testQueueInterface, "queue", "only-hardware-queue"),
+						),
+					},
+				},
+			})
+
+		})
+	}
+}
+
+func testAccQueueInterfaceConfig(param string) string {
+	return fmt.Sprintf(`%v
+
+resource "routeros_queue_interface" "test" {
+  interface = "ether1"
+  queue     = "%v"
+}
+`, providerConfig, param)
+}
+
+type testQueueInterfaceClient struct {
+	testOperationClient
+	items  []MikrotikItem
+	posted map[string]MikrotikItem
+}
+
+func (c *testQueueInterfaceClient) SendRequest(method crudMethod, url *URL, item MikrotikItem, result interface{}) error {
+	switch method {
+	case crudRead:
+		*result.(*[]MikrotikItem) = append(*result.(*[]MikrotikItem), c.items...)
+	case crudPost:
+		c.posted[url.Path] = item
+	}
+	return nil
+}
+
+func TestQueueInterfaceDelete(t *testing.T) {
+	tests := []struct {
+		name  string
+		items []MikrotikItem
+		want  MikrotikItem
+	}{
+		{"Default queue", []MikrotikItem{{".id": "*1", "default-queue": "only-hardware-queue"}},
+			MikrotikItem{".id": "*1", "queue": "only-hardware-queue"}},
+		{"Interface removed", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := ResourceQueueInterface()
+			d := r.TestResourceData()
+			d.SetId("*1")
+
+			c := &testQueueInterfaceClient{items: tt.items, posted: map[string]MikrotikItem{}}
+			if diags := r.DeleteContext(context.Background(), d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			got := c.posted["/queue/interface/set"]
+			if !reflect.DeepEqual(got, tt.want) || d.Id() != "" {
+				t.Errorf("posted = %v, id = %v, want %v", c.posted, d.Id(), tt.want)
+			}
+		})
+	}
+}
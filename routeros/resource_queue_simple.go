@@ -1,6 +1,7 @@
 package routeros
 
 import (
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -48,7 +49,7 @@ func ResourceQueueSimple() *schema.Resource {
 		MetaId:           PropId(Id),
 		MetaSkipFields: PropSkipFields("bytes", "dropped", "packet_rate", "packets", "queued_bytes", "queued_packets",
 			"rate", "total_bytes", "total_dropped", "total_packet_rate", "total_packets", "total_queued_bytes",
-			"total_queued_packets", "total_rate"),
+			"total_queued_packets", "total_rate", "place_before", "place_after"),
 
 		"bucket_size": {
 			Type:             schema.TypeString,
@@ -116,6 +117,8 @@ func ResourceQueueSimple() *schema.Resource {
 				"or any other previously created queue.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
 		},
+		KeyPlaceAfter:  PropPlaceAfter,
+		KeyPlaceBefore: PropPlaceBefore,
 		"priority": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -189,9 +192,9 @@ func ResourceQueueSimple() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		CreateContext: DefaultCreate(resSchema),
-		ReadContext:   DefaultRead(resSchema),
-		UpdateContext: DefaultUpdate(resSchema),
+		CreateContext: DefaultPlacedCreate(resSchema),
+		ReadContext:   DefaultPlacedRead(resSchema),
+		UpdateContext: DefaultPlacedUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
@@ -69,11 +69,11 @@ func ResourceRoutingTable() *schema.Resource {
 				r["fib"] = "no"
 			}
 
-			return MikrotikResourceDataToTerraform(r, resSchema, d)
+			return MikrotikResourceDataToTerraform(routerOSVersion(m), r, resSchema, d)
 		},
 
 		CreateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			item, metadata := TerraformResourceDataToMikrotik(resSchema, d)
+			item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
 
 			if b, ok := item["fib"]; ok {
 				if b == "no" {
@@ -103,8 +103,8 @@ func ResourceRoutingTable() *schema.Resource {
 			// Response ID.
 			d.SetId(res.GetID(Id))
 
-			// We ask for information again in the case of API.
-			if m.(Client).GetTransport() == TransportAPI {
+			// We ask for information again if the response only contains the ID (API, SSH).
+			if !hasItemProperties(res) {
 				r, err := ReadItems(&ItemId{Id, res.GetID(Id)}, metadata.Path, m.(Client))
 				if err != nil {
 					ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPut, err))
@@ -129,11 +129,11 @@ func ResourceRoutingTable() *schema.Resource {
 				}
 			}
 
-			return MikrotikResourceDataToTerraform(res, resSchema, d)
+			return MikrotikResourceDataToTerraform(routerOSVersion(m), res, resSchema, d)
 		},
 
 		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			item, metadata := TerraformResourceDataToMikrotik(resSchema, d)
+			item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
 
 			if b, ok := item["fib"]; ok {
 				if b == "no" {
@@ -163,13 +163,13 @@ func ResourceRoutingTable() *schema.Resource {
 				res["fib"] = "no"
 			}
 
-			return MikrotikResourceDataToTerraform(res, resSchema, d)
+			return MikrotikResourceDataToTerraform(routerOSVersion(m), res, resSchema, d)
 		},
 
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
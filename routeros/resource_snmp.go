@@ -62,9 +62,10 @@ func ResourceSNMP() *schema.Resource {
 			Type:     schema.TypeString,
 			Optional: true,
 			Computed: true,
-			Description: "What action will generate traps:\n  * interfaces - interface changes;\n  * start-trap - snmp " +
-				"server starting on the router.",
-			ValidateFunc: validation.StringInSlice([]string{"interfaces", "start-trap", "temp-exception"}, false),
+			Description: "A comma-separated list of actions that will generate traps:\n  * interfaces - interface " +
+				"changes;\n  * start-trap - snmp server starting on the router;\n  * temp-exception - temperature " +
+				"exceeding the limits.",
+			ValidateDiagFunc: ValidationMultiValInSlice([]string{"interfaces", "start-trap", "temp-exception"}, false, false),
 		},
 		"trap_interfaces": {
 			Type:        schema.TypeString,
@@ -54,7 +54,7 @@ func ResourceSNMPCommunity() *schema.Resource {
 			Optional:     true,
 			Default:      "MD5",
 			Description:  "The protocol used for authentication (SNMPv3).",
-			ValidateFunc: validation.StringInSlice([]string{"MD5", "SHA1"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"MD5", "SHA1", "SHA224", "SHA256", "SHA384", "SHA512"}, false),
 		},
 		KeyComment:  PropCommentRw,
 		KeyDefault:  PropDefaultRo,
@@ -106,7 +106,7 @@ func ResourceSNMPCommunity() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema:        resSchema,
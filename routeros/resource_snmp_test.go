@@ -74,7 +74,7 @@ resource "routeros_snmp" "test" {
 	engine_id_suffix = "8a3c"
 	location         = "Backyard"
 	trap_community   = "private"
-	trap_generators  = "start-trap"
+	trap_generators  = "interfaces,start-trap"
 	trap_version     = 3
 	depends_on = [routeros_snmp_community.test]
 }`,
@@ -0,0 +1,56 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+  ".id": "*1",
+  "channel": "0",
+  "disabled": "false",
+  "port": "serial0",
+  "user": "console"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Special+Login
+func ResourceSpecialLogin() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/special-login"),
+		MetaId:           PropId(Id),
+
+		"channel": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The channel of the port to connect to.",
+			ValidateFunc:     validation.IntAtLeast(0),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyDisabled: PropDisabledRw,
+		"port": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The port (`/port`) the user is connected to after logging in.",
+		},
+		"user": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of an existing system user that is connected to the port after logging in.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
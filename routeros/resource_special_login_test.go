@@ -0,0 +1,58 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testSpecialLogin = "routeros_special_login.test"
+
+func TestAccSpecialLoginTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/port", "name=serial0") {
+		t.Logf("Test skipped, the router does not have the serial0 port")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/special-login", "routeros_special_login"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccSpecialLoginConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testSpecialLogin),
+							resource.TestCheckResourceAttr(testSpecialLogin, "user", "test-special-login"),
+							resource.TestCheckResourceAttr(testSpecialLogin, "port", "serial0"),
+							resource.TestCheckResourceAttr(testSpecialLogin, "disabled", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccSpecialLoginConfig() string {
+	return providerConfig + `
+
+resource "routeros_system_user" "test" {
+  name     = "test-special-login"
+  group    = "read"
+  password = "secret"
+}
+
+# The disabled login does not take over the serial console.
+resource "routeros_special_login" "test" {
+  user     = routeros_system_user.test.name
+  port     = "serial0"
+  disabled = true
+}
+`
+}
@@ -0,0 +1,285 @@
+package routeros
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+  "activation-timeout": "5m",
+  "attempt-count": "0",
+  "bandwidth-test": "true",
+  "container": "false",
+  "email": "true",
+  "fetch": "true",
+  "flagged": "false",
+  "flagging-enabled": "true",
+  "hotspot": "true",
+  "install-any-version": "false",
+  "ipsec": "true",
+  "l2tp": "true",
+  "mode": "advanced",
+  "partitions": "false",
+  "pptp": "true",
+  "proxy": "true",
+  "romon": "true",
+  "routerboard": "false",
+  "scheduler": "true",
+  "smb": "true",
+  "sniffer": "true",
+  "socks": "true",
+  "traffic-gen": "false",
+  "zerotier": "true"
+}
+*/
+
+var errDeviceModeNotConfirmed = errors.New("the device-mode change was not confirmed")
+
+// https://help.mikrotik.com/docs/display/ROS/Device-mode
+func ResourceSystemDeviceMode() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/device-mode"),
+		MetaId:           PropId(Id),
+
+		"activation_timeout": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "The time in which the change must be confirmed by pressing a button or by a cold reboot " +
+				"(power-cycle) of the device.",
+			ValidateFunc:     ValidationTime,
+			DiffSuppressFunc: TimeEqual,
+		},
+		"attempt_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of unconfirmed device-mode change attempts.",
+		},
+		"bandwidth_test": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the bandwidth test.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"container": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of containers.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"email": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the e-mail tool.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"fetch": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the fetch tool.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"flagged": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "The device is flagged as possibly compromised by a suspicious configuration. Setting " +
+				"`false` clears the flag.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"flagging_enabled": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Whether the device can be flagged by suspicious configuration.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"hotspot": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of HotSpot.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"install_any_version": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the installation of any RouterOS version, including downgrades.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"ipsec": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of IPsec.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"l2tp": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of L2TP.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The device mode (`advanced`, `basic`, `home`, `rose` etc.).",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"partitions": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of partitions.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"pptp": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of PPTP.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"proxy": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the web proxy.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"romon": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of RoMON.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"routerboard": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of RouterBOARD settings.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"scheduler": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the scheduler.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"smb": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of SMB.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"sniffer": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the packet sniffer.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"socks": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of SOCKS.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"traffic_gen": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of the traffic generator.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"zerotier": {
+			Type:             schema.TypeBool,
+			Optional:         true,
+			Description:      "Allow the use of ZeroTier.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+	}
+
+	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		item, metadata := TerraformResourceDataToMikrotik(routerOSVersion(m), resSchema, d)
+
+		var resUrl string
+		if m.(Client).GetTransport() == TransportREST {
+			resUrl = "/update"
+		}
+
+		// The request is completed only after the change is confirmed or the activation timeout has expired.
+		// The REST session can end earlier, so the result is checked by polling the device-mode below.
+		err := m.(Client).SendRequest(crudDeviceModeUpdate, &URL{Path: metadata.Path + resUrl}, item, nil)
+		if netErr := net.Error(nil); errors.As(err, &netErr) && netErr.Timeout() {
+			ColorizedMessage(ctx, WARN, fmt.Sprintf("Waiting for the device-mode change to be confirmed: %v", err))
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		if err = waitDeviceMode(ctx, metadata.Path, item, timeout, m); err != nil {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  err.Error(),
+				Detail: "The device-mode change must be confirmed by pressing a button on the device or by " +
+					"a cold reboot (power-cycle) within the activation timeout.",
+			}}
+		}
+
+		return SystemResourceRead(ctx, resSchema, d, m)
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreateUpdate,
+		ReadContext:   DefaultSystemRead(resSchema),
+		UpdateContext: resCreateUpdate,
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: resSchema,
+	}
+}
+
+// waitDeviceMode Waits until the device-mode settings match the requested ones.
+func waitDeviceMode(ctx context.Context, path string, item MikrotikItem, timeout time.Duration, m interface{}) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"applied"},
+		Refresh: func() (interface{}, string, error) {
+			res := MikrotikItem{}
+			if err := m.(Client).SendRequest(crudRead, &URL{Path: path}, nil, &res); err != nil {
+				// The router does not respond while rebooting.
+				return res, "pending", nil
+			}
+
+			for k, v := range item {
+				if k == "activation-timeout" {
+					continue
+				}
+				if BoolFromMikrotikJSONStr(v) != BoolFromMikrotikJSONStr(res[k]) {
+					return res, "pending", nil
+				}
+			}
+
+			return res, "applied", nil
+		},
+		Timeout: timeout,
+		Delay:   time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("%w: %v", errDeviceModeNotConfirmed, err)
+	}
+
+	return nil
+}
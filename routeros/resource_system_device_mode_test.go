@@ -0,0 +1,50 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testSystemDeviceModeMinVersion = "7.17"
+const testSystemDeviceMode = "routeros_system_device_mode.test"
+
+func TestAccSystemDeviceModeTest_basic(t *testing.T) {
+	if !testCheckMinVersion(t, testSystemDeviceModeMinVersion) {
+		t.Logf("Test skipped, the minimum required version is %v", testSystemDeviceModeMinVersion)
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccSystemDeviceModeConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testSystemDeviceMode),
+							resource.TestCheckResourceAttr(testSystemDeviceMode, "activation_timeout", "5m"),
+							resource.TestCheckResourceAttrSet(testSystemDeviceMode, "mode"),
+							resource.TestCheckResourceAttrSet(testSystemDeviceMode, "attempt_count"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// The features are not changed: enabling them must be confirmed by pressing a button on the device.
+func testAccSystemDeviceModeConfig() string {
+	return providerConfig + `
+
+resource "routeros_system_device_mode" "test" {
+  activation_timeout = "5m"
+}
+`
+}
@@ -0,0 +1,175 @@
+package routeros
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+  ".id": "*2",
+  "available": "false",
+  "build-time": "2025-06-04 09:41:16",
+  "disabled": "false",
+  "name": "container",
+  "scheduled": "",
+  "size": "102472",
+  "version": "7.19.1"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Packages
+func ResourceSystemPackage() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/package"),
+		MetaId:           PropId(Id),
+		MetaSkipFields:   PropSkipFields("apply_changes"),
+
+		"apply_changes": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Apply the scheduled package changes right away. **The router reboots** to enable or " +
+				"disable the package. Otherwise the change takes effect after the next reboot. Set the provider " +
+				"`reboot_wait_timeout` to continue the apply when the router returns.",
+		},
+		"available": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the package is available for installation but not installed.",
+		},
+		"build_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Package build time.",
+		},
+		KeyDisabled: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Description: "Whether the package is disabled. A pending change that is scheduled for the next " +
+				"reboot is reported as the new state.",
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of an installed package.",
+		},
+		"scheduled": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The package change that is pending until the next reboot.",
+		},
+		"size": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Package size.",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Package version.",
+		},
+	}
+
+	resRead := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := ResourceRead(ctx, resSchema, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		d.Set(KeyDisabled, packageDisabled(d.Get(KeyDisabled).(bool), d.Get("scheduled").(string)))
+
+		return diags
+	}
+
+	resCreateUpdate := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		metadata := GetMetadata(resSchema)
+
+		res, err := ReadItems(&ItemId{Name, d.Get("name").(string)}, metadata.Path, m.(Client))
+		if err != nil {
+			// API/REST client error.
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(err)
+		}
+
+		// Resource not found.
+		if len(*res) == 0 {
+			d.SetId("")
+			ColorizedDebug(ctx, fmt.Sprintf(ErrorMsgPatch, err))
+			return diag.FromErr(errorNoLongerExists)
+		}
+
+		item := (*res)[0]
+		d.SetId(item.GetID(Id))
+
+		// Change the package state only if it is set in the configuration.
+		if !d.GetRawConfig().GetAttr(KeyDisabled).IsNull() {
+			disabled := packageDisabled(item[KeyDisabled] == "true", item["scheduled"])
+			if want := d.Get(KeyDisabled).(bool); want != disabled {
+				method, command := crudEnable, "/enable"
+				if want {
+					method, command = crudDisable, "/disable"
+				}
+
+				if diags := sendPackageCommand(method, command, MikrotikItem{"numbers": d.Id()}, m); diags.HasError() {
+					return diags
+				}
+			}
+		}
+
+		if diags := resRead(ctx, d, m); diags.HasError() {
+			return diags
+		}
+
+		if d.Get("apply_changes").(bool) && d.Get("scheduled").(string) != "" {
+			ColorizedMessage(ctx, WARN, "Applying the package changes, the router will be rebooted.")
+			return sendPackageCommand(crudApplyChanges, "/apply-changes", MikrotikItem{}, m)
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: resCreateUpdate,
+		ReadContext:   resRead,
+		UpdateContext: resCreateUpdate,
+		DeleteContext: DefaultSystemDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resSchema,
+	}
+}
+
+func sendPackageCommand(method crudMethod, command string, item MikrotikItem, m interface{}) diag.Diagnostics {
+	var resUrl = &URL{
+		Path: "/system/package",
+	}
+	if m.(Client).GetTransport() == TransportREST {
+		resUrl.Path += command
+	}
+
+	if err := m.(Client).SendRequest(method, resUrl, item, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// packageDisabled The package state changes only after a reboot, so the pending change is reported as the state.
+func packageDisabled(disabled bool, scheduled string) bool {
+	switch {
+	case strings.Contains(scheduled, "disable"):
+		return true
+	case strings.Contains(scheduled, "enable"):
+		return false
+	}
+	return disabled
+}
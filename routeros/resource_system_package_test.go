@@ -0,0 +1,46 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testSystemPackage = "routeros_system_package.test"
+
+func TestAccSystemPackageTest_basic(t *testing.T) {
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccSystemPackageConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testSystemPackage),
+							resource.TestCheckResourceAttr(testSystemPackage, "name", "routeros"),
+							resource.TestCheckResourceAttr(testSystemPackage, "disabled", "false"),
+							resource.TestCheckResourceAttr(testSystemPackage, "scheduled", ""),
+							resource.TestCheckResourceAttrSet(testSystemPackage, "version"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// The main package cannot be disabled, the test does not schedule the changes that need a reboot.
+func testAccSystemPackageConfig() string {
+	return providerConfig + `
+
+resource "routeros_system_package" "test" {
+  name     = "routeros"
+  disabled = false
+}
+`
+}
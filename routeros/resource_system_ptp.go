@@ -0,0 +1,79 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+{
+  ".id": "*1",
+  "delay-mode": "auto",
+  "disabled": "false",
+  "domain": "0",
+  "name": "ptp1",
+  "priority1": "128",
+  "priority2": "128",
+  "profile": "default"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Precision+Time+Protocol
+func ResourceSystemPtp() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/ptp"),
+		MetaId:           PropId(Id),
+
+		KeyComment: PropCommentRw,
+		"delay_mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The delay measurement mechanism: `auto`, `e2e` (end-to-end) or `p2p` (peer-to-peer).",
+			ValidateFunc:     validation.StringInSlice([]string{"auto", "e2e", "p2p"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyDisabled: PropDisabledRw,
+		"domain": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "PTP domain number. Clocks only synchronize within the same domain.",
+			ValidateFunc:     validation.IntBetween(0, 255),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		KeyName: PropName("Name of the PTP instance."),
+		"priority1": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The first priority value of the best master clock algorithm. Lower values take precedence.",
+			ValidateFunc:     validation.IntBetween(0, 255),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"priority2": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Description:      "The second priority value of the best master clock algorithm. Lower values take precedence.",
+			ValidateFunc:     validation.IntBetween(0, 255),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+		"profile": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "PTP profile: `default`, `802.1as` or `g8275.1`.",
+			ValidateFunc:     validation.StringInSlice([]string{"802.1as", "default", "g8275.1"}, false),
+			DiffSuppressFunc: AlwaysPresentNotUserProvided,
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
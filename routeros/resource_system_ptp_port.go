@@ -0,0 +1,45 @@
+package routeros
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+{
+  ".id": "*1",
+  "interface": "ether1",
+  "ptp": "ptp1"
+}
+*/
+
+// https://help.mikrotik.com/docs/display/ROS/Precision+Time+Protocol
+func ResourceSystemPtpPort() *schema.Resource {
+	resSchema := map[string]*schema.Schema{
+		MetaResourcePath: PropResourcePath("/system/ptp/port"),
+		MetaId:           PropId(Id),
+
+		"interface": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the interface that takes part in the PTP instance.",
+		},
+		"ptp": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the PTP instance.",
+		},
+	}
+
+	return &schema.Resource{
+		CreateContext: DefaultCreate(resSchema),
+		ReadContext:   DefaultRead(resSchema),
+		UpdateContext: DefaultUpdate(resSchema),
+		DeleteContext: DefaultDelete(resSchema),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportStateCustomContext(resSchema),
+		},
+
+		Schema: resSchema,
+	}
+}
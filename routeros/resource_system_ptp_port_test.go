@@ -0,0 +1,53 @@
+package routeros
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testSystemPtpPort = "routeros_system_ptp_port.test"
+
+func TestAccSystemPtpPortTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/system/ptp") || !testCheckRouterItems(t, "/interface/ethernet", "name=ether1") {
+		t.Logf("Test skipped, the router does not support PTP on ether1")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/system/ptp/port", "routeros_system_ptp_port"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccSystemPtpPortConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testSystemPtpPort),
+							resource.TestCheckResourceAttr(testSystemPtpPort, "interface", "ether1"),
+							resource.TestCheckResourceAttr(testSystemPtpPort, "ptp", "test-ptp"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccSystemPtpPortConfig() string {
+	return providerConfig + `
+
+resource "routeros_system_ptp" "test" {
+  name = "test-ptp"
+}
+
+resource "routeros_system_ptp_port" "test" {
+  interface = "ether1"
+  ptp       = routeros_system_ptp.test.name
+}
+`
+}
@@ -0,0 +1,67 @@
+package routeros
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testSystemPtp = "routeros_system_ptp.test"
+
+func TestAccSystemPtpTest_basic(t *testing.T) {
+	if !testCheckRouterItems(t, "/system/ptp") {
+		t.Logf("Test skipped, the router does not support PTP")
+		return
+	}
+
+	for _, name := range testNames {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				PreCheck: func() {
+					testAccPreCheck(t)
+					testSetTransportEnv(t, name)
+				},
+				ProviderFactories: testAccProviderFactories,
+				CheckDestroy:      testCheckResourceDestroy("/system/ptp", "routeros_system_ptp"),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccSystemPtpConfig(),
+						Check: resource.ComposeTestCheckFunc(
+							testResourcePrimaryInstanceId(testSystemPtp),
+							resource.TestCheckResourceAttr(testSystemPtp, "name", "test-ptp"),
+							resource.TestCheckResourceAttr(testSystemPtp, "profile", "default"),
+							resource.TestCheckResourceAttr(testSystemPtp, "priority1", "100"),
+							resource.TestCheckResourceAttr(testSystemPtp, "domain", "0"),
+						),
+					},
+					{
+						Config:        testAccSystemPtpConfig(),
+						ResourceName:  testSystemPtp,
+						ImportStateId: `name=test-ptp`,
+						ImportState:   true,
+						ImportStateCheck: func(states []*terraform.InstanceState) error {
+							if len(states) != 1 {
+								return fmt.Errorf("more than 1 states received, only one expected")
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func testAccSystemPtpConfig() string {
+	return providerConfig + `
+
+resource "routeros_system_ptp" "test" {
+  name      = "test-ptp"
+  profile   = "default"
+  priority1 = 100
+  domain    = 0
+}
+`
+}
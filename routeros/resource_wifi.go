@@ -33,6 +33,7 @@ import (
 func ResourceWifi() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
 		MetaTransformSet: PropTransformSet("aaa.config: aaa", "channel.config: channel", "configuration.config: configuration",
 			"datapath.config: datapath", "interworking.config: interworking", "security.config: security", "steering.config: steering"),
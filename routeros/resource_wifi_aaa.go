@@ -23,6 +23,7 @@ import (
 func ResourceWifiAaa() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/aaa"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
 
 		"called_format": {
@@ -73,7 +74,7 @@ func ResourceWifiAaa() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -1,9 +1,6 @@
 package routeros
 
 import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -31,13 +28,15 @@ import (
 func ResourceWifiAccessList() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/access-list"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
+		MetaSkipFields:   PropSkipFields("place_before", "place_after"),
 
 		"action": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "accept",
-			Description: "An action to take when a client matches.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "accept",
+			Description:  "An action to take when a client matches.",
 			ValidateFunc: validation.StringInSlice([]string{"accept", "reject", "query-radius"}, false),
 		},
 		"allow_signal_out_of_range": {
@@ -50,11 +49,11 @@ func ResourceWifiAccessList() *schema.Resource {
 			Optional:    true,
 			Description: "An option that specifies whether to deny forwarding data between clients connected to the same interface.",
 		},
-		KeyComment: PropCommentRw,
+		KeyComment:  PropCommentRw,
 		KeyDisabled: PropDisabledRw,
 		"interface": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:        schema.TypeString,
+			Optional:    true,
 			Description: "Interface name to compare with an interface to which the client actually connects to.",
 		},
 		"mac_address": {
@@ -68,6 +67,7 @@ func ResourceWifiAccessList() *schema.Resource {
 			Optional:    true,
 			Description: "MAC address mask to apply when comparing clients' addresses.",
 		},
+		KeyPlaceAfter:  PropPlaceAfter,
 		KeyPlaceBefore: PropPlaceBefore,
 		"passphrase": {
 			Type:        schema.TypeString,
@@ -85,8 +85,8 @@ func ResourceWifiAccessList() *schema.Resource {
 			Description: "The range in which the client signal must fall.",
 		},
 		"ssid_regexp": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:        schema.TypeString,
+			Optional:    true,
 			Description: "The regular expression to compare the actual SSID the client connects to.",
 		},
 		"time": {
@@ -104,20 +104,13 @@ func ResourceWifiAccessList() *schema.Resource {
 
 	return &schema.Resource{
 		Description:   `*<span style="color:red">This resource requires a minimum version of RouterOS 7.13.</span>*`,
-		CreateContext: DefaultCreate(resSchema),
-		ReadContext:   DefaultRead(resSchema),
-		UpdateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			resSchema[MetaSkipFields].Default = `"place_before"`
-			defer func() {
-				resSchema[MetaSkipFields].Default = ``
-			}()
-
-			return ResourceUpdate(ctx, resSchema, d, m)
-		},
+		CreateContext: DefaultPlacedCreate(resSchema),
+		ReadContext:   DefaultPlacedRead(resSchema),
+		UpdateContext: DefaultPlacedUpdate(resSchema),
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -25,6 +25,7 @@ import (
 func ResourceWifiCap() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/cap"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Name),
 
 		"caps_man_addresses": {
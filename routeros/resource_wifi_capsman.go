@@ -23,6 +23,7 @@ import (
 func ResourceWifiCapsman() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/capsman"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Name),
 
 		"ca_certificate": {
@@ -22,6 +22,7 @@ import (
 func ResourceWifiChannel() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/channel"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
 
 		"band": {
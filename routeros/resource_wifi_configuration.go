@@ -37,6 +37,7 @@ import (
 func ResourceWifiConfiguration() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/configuration"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
 		MetaTransformSet: PropTransformSet("aaa.config: aaa", "channel.config: channel", "datapath.config: datapath",
 			"interworking.config: interworking", "security.config: security", "steering.config: steering"),
@@ -185,7 +186,7 @@ func ResourceWifiConfiguration() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
@@ -23,6 +23,7 @@ import (
 func ResourceWifiDatapath() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/datapath"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
 
 		"bridge": {
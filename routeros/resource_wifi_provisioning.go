@@ -25,6 +25,7 @@ import (
 func ResourceWifiProvisioning() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/provisioning"),
+		MetaMinVersion:   PropMinVersion("7.13"),
 		MetaId:           PropId(Id),
 
 		"action": {
@@ -78,12 +79,12 @@ func ResourceWifiProvisioning() *schema.Resource {
 			Description: "If action specifies to create interfaces, then a new slave interface for each configuration " +
 				"profile in this list is created.",
 		},
-		"slave_name_format": {
+		"slave_name_format": WithMinVersion("7.16", &schema.Schema{
 			Type:             schema.TypeString,
 			Optional:         true,
-			Description:      "The name format of the slave CAP interfaces. This option is available in RouterOS starting from version 7.16.",
+			Description:      "The name format of the slave CAP interfaces.",
 			DiffSuppressFunc: AlwaysPresentNotUserProvided,
-		},
+		}),
 		"supported_bands": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -103,7 +104,7 @@ func ResourceWifiProvisioning() *schema.Resource {
 		DeleteContext: DefaultDelete(resSchema),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: ImportStateCustomContext(resSchema),
 		},
 
 		Schema: resSchema,
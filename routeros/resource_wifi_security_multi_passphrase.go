@@ -19,6 +19,7 @@ import (
 func ResourceWifiSecurityMultiPassphrase() *schema.Resource {
 	resSchema := map[string]*schema.Schema{
 		MetaResourcePath: PropResourcePath("/interface/wifi/security/multi-passphrase"),
+		MetaMinVersion:   PropMinVersion("7.17"),
 		MetaId:           PropId(Id),
 
 		KeyComment:  PropCommentRw,